@@ -5,4 +5,5 @@ go 1.24.4
 require (
 	github.com/golang-jwt/jwt/v5 v5.3.0
 	github.com/lib/pq v1.10.9
+	golang.org/x/text v0.34.0
 )
@@ -0,0 +1,98 @@
+package validation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/EugeneNail/motivatr-lib-common/pkg/validation/rules"
+	"io"
+	"net/http"
+)
+
+// DefaultMaxBodySize limits json bodies when no other limit is given.
+const DefaultMaxBodySize int64 = 1 << 20
+
+// DecodeJsonBody decodes a json object from the request body of at most maxBytes bytes (DefaultMaxBodySize if not positive).
+// Integers that fit into int64 are decoded as int64 and the other numbers are kept as json.Number, so that rules see
+// them as numbers of the right kind without losing precision, e.g. 18446744073709551615 or 0.1 are compared exactly.
+// An empty body is decoded as an empty object.
+func DecodeJsonBody(request *http.Request, maxBytes int64) (map[string]any, error) {
+	if maxBytes <= 0 {
+		maxBytes = DefaultMaxBodySize
+	}
+
+	decoder := json.NewDecoder(http.MaxBytesReader(nil, request.Body, maxBytes))
+	decoder.UseNumber()
+
+	var data map[string]any
+	if err := decoder.Decode(&data); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("decoding the request body: %w", err)
+	}
+
+	if err := decoder.Decode(&struct{}{}); !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("the request body must contain a single json object")
+	}
+
+	if data == nil {
+		data = make(map[string]any)
+	}
+
+	return convertNumbers(data).(map[string]any), nil
+}
+
+func convertNumbers(value any) any {
+	switch typedValue := value.(type) {
+	case json.Number:
+		if integer, err := typedValue.Int64(); err == nil {
+			return integer
+		}
+	case map[string]any:
+		for key, item := range typedValue {
+			typedValue[key] = convertNumbers(item)
+		}
+	case []any:
+		for i, item := range typedValue {
+			typedValue[i] = convertNumbers(item)
+		}
+	}
+
+	return value
+}
+
+// ValidateJsonBody decodes the request body with DecodeJsonBody and validates it. The status and the response are meant
+// to be returned from a WebHandlerFunc as they are when the response is not nil: 400 or 413 with an error when the body
+// cannot be decoded, 422 with the validation errors when the data is invalid. The returned data is sanitized
+// if the options include WithSanitizers.
+//
+//	data, status, response := validation.ValidateJsonBody(request, 0, ruleFuncs)
+//	if response != nil {
+//		return status, response
+//	}
+func ValidateJsonBody(request *http.Request, maxBytes int64, ruleFuncs map[string][]rules.Rule, options ...Option) (data map[string]any, status int, response any) {
+	data, err := DecodeJsonBody(request, maxBytes)
+
+	var maxBytesError *http.MaxBytesError
+	if errors.As(err, &maxBytesError) {
+		return nil, http.StatusRequestEntityTooLarge, err
+	}
+
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+
+	return validateData(request.Context(), NewValidator(data, ruleFuncs, options...))
+}
+
+// validateData runs the validator and turns the result into the status and the response of a WebHandlerFunc.
+func validateData(ctx context.Context, validator *Validator) (map[string]any, int, any) {
+	if err := validator.ValidateContext(ctx); err != nil {
+		return nil, http.StatusInternalServerError, fmt.Errorf("validating the request: %w", err)
+	}
+
+	if validator.Failed() {
+		return nil, http.StatusUnprocessableEntity, validator.Errors()
+	}
+
+	return validator.Data(), http.StatusOK, nil
+}
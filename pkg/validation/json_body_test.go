@@ -0,0 +1,86 @@
+package validation
+
+import (
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/EugeneNail/motivatr-lib-common/pkg/validation/rules"
+)
+
+func TestDecodeJsonBodyPreservesIntegers(t *testing.T) {
+	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"count": 15, "ratio": 0.5, "huge": 18446744073709551615, "steps": [{"order": 2}]}`))
+
+	data, err := DecodeJsonBody(request, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, ok := data["count"].(int64); !ok {
+		t.Errorf("got %T for an integer, want int64", data["count"])
+	}
+
+	if got, want := data["ratio"], json.Number("0.5"); got != want {
+		t.Errorf("got %#v for a fraction, want %#v", got, want)
+	}
+
+	if got, want := data["huge"], json.Number("18446744073709551615"); got != want {
+		t.Errorf("got %#v for an integer beyond int64, want %#v", got, want)
+	}
+
+	order := data["steps"].([]any)[0].(map[string]any)["order"]
+	if _, ok := order.(int64); !ok {
+		t.Errorf("got %T for a nested integer, want int64", order)
+	}
+}
+
+func TestValidateJsonBody(t *testing.T) {
+	ruleFuncs := map[string][]rules.Rule{
+		"title": {rules.Required(), rules.Max(10)},
+		"count": {rules.Max(10)},
+		"email": {rules.Regex(rules.Email)},
+		"limit": {rules.Integer(), rules.Max(uint64(math.MaxUint64))},
+	}
+
+	tableTests := []struct {
+		name       string
+		body       string
+		maxBytes   int64
+		wantStatus int
+	}{
+		{"Valid body", `{"title": "Read", "count": 5}`, 0, http.StatusOK},
+		{"Invalid data", `{"title": "Read", "count": 15}`, 0, http.StatusUnprocessableEntity},
+		{"Missing field under a regex rule", `{"title": "Read"}`, 0, http.StatusOK},
+		{"Null under a regex rule", `{"title": "Read", "email": null}`, 0, http.StatusOK},
+		{"Integer beyond int64", `{"title": "Read", "limit": 18446744073709551615}`, 0, http.StatusOK},
+		{"Integer beyond uint64", `{"title": "Read", "limit": 18446744073709551616}`, 0, http.StatusUnprocessableEntity},
+		{"Number under a regex rule", `{"title": "Read", "email": 5}`, 0, http.StatusUnprocessableEntity},
+		{"Empty body", ``, 0, http.StatusUnprocessableEntity},
+		{"Malformed json", `{"title": `, 0, http.StatusBadRequest},
+		{"Not an object", `["Read"]`, 0, http.StatusBadRequest},
+		{"Several objects", `{"title": "Read"} {"title": "Write"}`, 0, http.StatusBadRequest},
+		{"Too large body", `{"title": "Read", "count": 5}`, 10, http.StatusRequestEntityTooLarge},
+	}
+
+	for _, tt := range tableTests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(tt.body))
+
+			data, status, response := ValidateJsonBody(request, tt.maxBytes, ruleFuncs)
+			if status != tt.wantStatus {
+				t.Fatalf("got status %d, want %d (response %v)", status, tt.wantStatus, response)
+			}
+
+			if status == http.StatusOK && (response != nil || data["title"] != "Read") {
+				t.Errorf("got data %v and response %v", data, response)
+			}
+
+			if status != http.StatusOK && response == nil {
+				t.Errorf("expected a response")
+			}
+		})
+	}
+}
@@ -0,0 +1,329 @@
+package validation
+
+import (
+	"github.com/EugeneNail/motivatr-lib-common/pkg/validation/rules"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// JsonSchema describes the data accepted by the rules as a JSON Schema object, e.g. to publish it in OpenAPI documents.
+// Nested paths become nested objects and wildcards become array items. Polymorphic rules like rules.Max yield the
+// keywords of every kind they apply to (maxLength, maximum, maxItems) unless other rules reveal the type of the field.
+// Rules that depend on other fields and custom rules that are not rules.Described are not represented.
+func JsonSchema(ruleFuncs map[string][]rules.Rule) map[string]any {
+	root := newSchemaNode()
+
+	for path, fieldRuleFuncs := range ruleFuncs {
+		var descriptors []rules.Descriptor
+		for _, ruleFunc := range fieldRuleFuncs {
+			descriptors = append(descriptors, rules.Describe(ruleFunc)...)
+		}
+
+		parent, node, name := root.resolve(path)
+		node.describe(descriptors)
+
+		for _, descriptor := range descriptors {
+			if descriptor.Name == "required" && name != wildcard {
+				parent.required[name] = true
+			}
+		}
+	}
+
+	return root.render()
+}
+
+type schemaNode struct {
+	descriptors          []rules.Descriptor
+	properties           map[string]*schemaNode
+	items                *schemaNode
+	propertyNames        *schemaNode
+	additionalProperties *schemaNode
+	required             map[string]bool
+}
+
+func newSchemaNode() *schemaNode {
+	return &schemaNode{
+		properties: make(map[string]*schemaNode),
+		required:   make(map[string]bool),
+	}
+}
+
+// describe attaches the descriptors to the node, moving the ones of rules.Each, rules.Keys and rules.Values
+// to the nodes describing the items, the keys and the values.
+func (node *schemaNode) describe(descriptors []rules.Descriptor) {
+	for _, descriptor := range descriptors {
+		switch descriptor.Name {
+		case "each":
+			if node.items == nil {
+				node.items = newSchemaNode()
+			}
+
+			node.items.describe(descriptor.Children)
+		case "keys":
+			if node.propertyNames == nil {
+				node.propertyNames = newSchemaNode()
+			}
+
+			node.propertyNames.describe(descriptor.Children)
+		case "values":
+			if node.additionalProperties == nil {
+				node.additionalProperties = newSchemaNode()
+			}
+
+			node.additionalProperties.describe(descriptor.Children)
+		default:
+			node.descriptors = append(node.descriptors, descriptor)
+		}
+	}
+}
+
+// resolve returns the node of the path, creating the missing ones, together with its parent and its last segment.
+func (node *schemaNode) resolve(path string) (parent *schemaNode, current *schemaNode, name string) {
+	current = node
+	for _, segment := range strings.Split(path, pathSeparator) {
+		parent, name = current, segment
+
+		if segment == wildcard {
+			if current.items == nil {
+				current.items = newSchemaNode()
+			}
+
+			current = current.items
+			continue
+		}
+
+		if _, exists := current.properties[segment]; !exists {
+			current.properties[segment] = newSchemaNode()
+		}
+
+		current = current.properties[segment]
+	}
+
+	return parent, current, name
+}
+
+func (node *schemaNode) render() map[string]any {
+	schema := make(map[string]any)
+
+	schemaType := node.schemaType()
+	if schemaType != nil {
+		schema["type"] = schemaType
+	}
+
+	var patterns []string
+	for _, descriptor := range node.descriptors {
+		switch descriptor.Name {
+		case "max":
+			setSizeKeywords(schema, schemaType, "maxLength", "maximum", "maxItems", descriptor.Params["limit"])
+		case "min":
+			setSizeKeywords(schema, schemaType, "minLength", "minimum", "minItems", descriptor.Params["limit"])
+		case "between":
+			setSizeKeywords(schema, schemaType, "minLength", "minimum", "minItems", descriptor.Params["min"])
+			setSizeKeywords(schema, schemaType, "maxLength", "maximum", "maxItems", descriptor.Params["max"])
+		case "regex":
+			pattern := descriptor.Params["pattern"].(string)
+			if pattern == rules.Email {
+				schema["format"] = "email"
+			}
+
+			patterns = append(patterns, pattern)
+		case "date":
+			schema["format"] = "date"
+		case "date_time":
+			schema["format"] = "date-time"
+		case "time":
+			patterns = append(patterns, rules.TimePattern)
+		case "file", "max_file_size", "mime_types", "extensions", "image":
+			schema["format"] = "binary"
+		case "uuid":
+			schema["format"] = "uuid"
+		case "url":
+			schema["format"] = "uri"
+		case "phone", "hex_color", "slug":
+			patterns = append(patterns, descriptor.Params["pattern"].(string))
+		case "password":
+			schema["format"] = "password"
+			schema["minLength"] = descriptor.Params["min_length"]
+			if maxLength, exists := descriptor.Params["max_length"]; exists {
+				schema["maxLength"] = maxLength
+			}
+		case "decimal":
+			schema["multipleOf"] = math.Pow10(-descriptor.Params["places"].(int))
+		case "multiple_of":
+			schema["multipleOf"] = descriptor.Params["value"]
+		case "positive":
+			schema["exclusiveMinimum"] = 0
+		case "non_negative":
+			schema["minimum"] = 0
+		case "in":
+			schema["enum"] = descriptor.Params["values"]
+		case "not_in":
+			schema["not"] = map[string]any{"enum": descriptor.Params["values"]}
+		case "distinct":
+			schema["uniqueItems"] = true
+		case "any_of":
+			var anyOf []any
+			for _, branch := range descriptor.Children {
+				anyOf = append(anyOf, renderDescriptors(branch.Children))
+			}
+
+			schema["anyOf"] = anyOf
+		case "not":
+			schema["not"] = renderDescriptors(descriptor.Children)
+		}
+	}
+
+	if len(patterns) > 0 {
+		schema["pattern"] = patterns[0]
+	}
+
+	if len(patterns) > 1 {
+		var allOf []any
+		for _, pattern := range patterns[1:] {
+			allOf = append(allOf, map[string]any{"pattern": pattern})
+		}
+
+		schema["allOf"] = allOf
+	}
+
+	if len(node.properties) > 0 {
+		properties := make(map[string]any, len(node.properties))
+		for name, property := range node.properties {
+			properties[name] = property.render()
+		}
+
+		schema["properties"] = properties
+	}
+
+	if len(node.required) > 0 {
+		required := make([]string, 0, len(node.required))
+		for name := range node.required {
+			required = append(required, name)
+		}
+
+		sort.Strings(required)
+		schema["required"] = required
+	}
+
+	if node.items != nil {
+		schema["items"] = node.items.render()
+	}
+
+	if node.propertyNames != nil {
+		schema["propertyNames"] = node.propertyNames.render()
+	}
+
+	if node.additionalProperties != nil {
+		schema["additionalProperties"] = node.additionalProperties.render()
+	}
+
+	return schema
+}
+
+func renderDescriptors(descriptors []rules.Descriptor) map[string]any {
+	node := newSchemaNode()
+	node.describe(descriptors)
+
+	return node.render()
+}
+
+// schemaType infers the type from the structure of the node and the rules that only apply to specific types.
+func (node *schemaNode) schemaType() any {
+	var schemaType string
+	nullable := false
+
+	switch {
+	case len(node.properties) > 0, node.propertyNames != nil, node.additionalProperties != nil:
+		schemaType = "object"
+	case node.items != nil:
+		schemaType = "array"
+	}
+
+	for _, descriptor := range node.descriptors {
+		switch descriptor.Name {
+		case "regex", "date", "date_time", "time", "date_format", "password",
+			"uuid", "url", "ip", "cidr", "phone", "timezone", "hex_color", "slug", "json",
+			"alpha", "alpha_num", "san", "sand", "file", "max_file_size", "mime_types", "extensions", "image":
+			schemaType = "string"
+		case "integer":
+			schemaType = "integer"
+		case "numeric", "decimal", "multiple_of", "positive", "non_negative":
+			if schemaType != "integer" {
+				schemaType = "number"
+			}
+		case "in":
+			if valuesType := jsonType(descriptor.Params["values"].([]any)); len(valuesType) > 0 {
+				schemaType = valuesType
+			}
+		case "distinct":
+			schemaType = "array"
+		case "nullable":
+			nullable = true
+		}
+	}
+
+	if len(schemaType) == 0 {
+		return nil
+	}
+
+	if nullable {
+		return []string{schemaType, "null"}
+	}
+
+	return schemaType
+}
+
+// jsonType returns the common json type of the values or an empty string if they differ.
+func jsonType(values []any) string {
+	common := ""
+	for _, value := range values {
+		var valueType string
+		switch reflect.ValueOf(value).Kind() {
+		case reflect.String:
+			valueType = "string"
+		case reflect.Bool:
+			valueType = "boolean"
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			valueType = "integer"
+		case reflect.Float32, reflect.Float64:
+			valueType = "number"
+		default:
+			return ""
+		}
+
+		switch {
+		case len(common) == 0 || common == valueType:
+			common = valueType
+		case common == "integer" && valueType == "number", common == "number" && valueType == "integer":
+			common = "number"
+		default:
+			return ""
+		}
+	}
+
+	return common
+}
+
+func setSizeKeywords(schema map[string]any, schemaType any, lengthKeyword string, valueKeyword string, itemsKeyword string, limit any) {
+	types, _ := schemaType.([]string)
+	if len(types) > 0 {
+		schemaType = types[0]
+	}
+
+	switch schemaType {
+	case "string":
+		schema[lengthKeyword] = limit
+	case "integer", "number":
+		schema[valueKeyword] = limit
+	case "array":
+		schema[itemsKeyword] = limit
+	case "object":
+	default:
+		schema[lengthKeyword] = limit
+		schema[valueKeyword] = limit
+		schema[itemsKeyword] = limit
+	}
+}
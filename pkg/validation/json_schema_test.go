@@ -0,0 +1,80 @@
+package validation
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/EugeneNail/motivatr-lib-common/pkg/validation/rules"
+)
+
+func TestJsonSchema(t *testing.T) {
+	ruleFuncs := map[string][]rules.Rule{
+		"title":        {rules.Required(), rules.Max(255)},
+		"email":        {rules.Required(), rules.Regex(rules.Email)},
+		"birthday":     {rules.Nullable(), rules.Date()},
+		"frequency":    {rules.Required(), rules.In("daily", "weekly")},
+		"priority":     {rules.Between(1, 5), rules.In(1, 2, 3, 4, 5)},
+		"goal.title":   {rules.Required(), rules.Min(3)},
+		"steps.*.name": {rules.Required(), rules.Regex(rules.Alpha)},
+		"tags":         {rules.Max(10)},
+		"labels":       {rules.Distinct(), rules.Each(rules.Regex(rules.Alpha), rules.Max(20))},
+		"meta":         {rules.Keys(rules.Regex(rules.Alpha)), rules.Values(rules.Max(50))},
+	}
+
+	want := `{
+		"properties": {
+			"birthday": {"format": "date", "type": ["string", "null"]},
+			"email": {"format": "email", "pattern": "^[\\w.+-]+@[\\w.+-]+\\.[a-zA-Z]{1,10}$", "type": "string"},
+			"frequency": {"enum": ["daily", "weekly"], "type": "string"},
+			"labels": {"items": {"maxLength": 20, "pattern": "^[a-zA-Z]+$", "type": "string"}, "type": "array", "uniqueItems": true},
+			"meta": {
+				"additionalProperties": {"maxItems": 50, "maxLength": 50, "maximum": 50},
+				"propertyNames": {"pattern": "^[a-zA-Z]+$", "type": "string"},
+				"type": "object"
+			},
+			"goal": {
+				"properties": {"title": {"minItems": 3, "minLength": 3, "minimum": 3}},
+				"required": ["title"],
+				"type": "object"
+			},
+			"priority": {"enum": [1, 2, 3, 4, 5], "maximum": 5, "minimum": 1, "type": "integer"},
+			"steps": {
+				"items": {
+					"properties": {"name": {"pattern": "^[a-zA-Z]+$", "type": "string"}},
+					"required": ["name"],
+					"type": "object"
+				},
+				"type": "array"
+			},
+			"tags": {"maxItems": 10, "maxLength": 10, "maximum": 10},
+			"title": {"maxItems": 255, "maxLength": 255, "maximum": 255}
+		},
+		"required": ["email", "frequency", "title"],
+		"type": "object"
+	}`
+
+	assertJsonEqual(t, JsonSchema(ruleFuncs), want)
+}
+
+func assertJsonEqual(t *testing.T, got any, want string) {
+	t.Helper()
+
+	gotJson, err := json.Marshal(got)
+	if err != nil {
+		t.Fatalf("cannot marshal the result: %v", err)
+	}
+
+	var normalized any
+	if err := json.Unmarshal([]byte(want), &normalized); err != nil {
+		t.Fatalf("cannot unmarshal the expected json: %v", err)
+	}
+
+	wantJson, err := json.Marshal(normalized)
+	if err != nil {
+		t.Fatalf("cannot marshal the expected json: %v", err)
+	}
+
+	if string(gotJson) != string(wantJson) {
+		t.Errorf("got %s\nwant %s", gotJson, wantJson)
+	}
+}
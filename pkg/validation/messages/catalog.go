@@ -0,0 +1,162 @@
+package messages
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Catalog is a Translator backed by message templates and field display names of a single language.
+type Catalog struct {
+	Locale   string
+	Messages map[string]string
+	Fields   map[string]string
+}
+
+// Translate renders the message with the catalog template. Messages without a template fall back to their
+// Text and then to their Key, so custom messages are rendered as they are.
+func (catalog *Catalog) Translate(message *Message) string {
+	template := message.Text
+	if len(template) == 0 {
+		template = catalog.Messages[message.Key]
+	}
+
+	if len(template) == 0 {
+		template = message.Key
+	}
+
+	if !strings.Contains(template, ":") {
+		return template
+	}
+
+	names := make([]string, 0, len(message.Params)+1)
+	values := map[string]string{"field": catalog.FieldName(message.Field)}
+	for name, value := range message.Params {
+		if field, isField := value.(Field); isField {
+			values[name] = catalog.FieldName(string(field))
+		} else {
+			values[name] = fmt.Sprint(value)
+		}
+	}
+
+	for name := range values {
+		names = append(names, name)
+	}
+
+	// Longer names go first so that :limit is not replaced inside :limits.
+	sort.Slice(names, func(i, j int) bool {
+		return len(names[i]) > len(names[j])
+	})
+
+	pairs := make([]string, 0, len(names)*2)
+	for _, name := range names {
+		pairs = append(pairs, ":"+name, values[name])
+	}
+
+	return strings.NewReplacer(pairs...).Replace(template)
+}
+
+// FieldName returns the display name of the field. Concrete paths like "steps.3.name" also match
+// display names declared for their wildcard form "steps.*.name".
+func (catalog *Catalog) FieldName(field string) string {
+	if name, exists := catalog.Fields[field]; exists {
+		return name
+	}
+
+	segments := strings.Split(field, ".")
+	for i, segment := range segments {
+		if _, err := strconv.Atoi(segment); err == nil {
+			segments[i] = "*"
+		}
+	}
+
+	if name, exists := catalog.Fields[strings.Join(segments, ".")]; exists {
+		return name
+	}
+
+	return field
+}
+
+// WithMessages returns a copy of the catalog with the templates added or replaced.
+func (catalog *Catalog) WithMessages(messages map[string]string) *Catalog {
+	clone := catalog.clone()
+	for key, template := range messages {
+		clone.Messages[key] = template
+	}
+
+	return clone
+}
+
+// WithFields returns a copy of the catalog with the field display names added or replaced.
+func (catalog *Catalog) WithFields(fields map[string]string) *Catalog {
+	clone := catalog.clone()
+	for field, name := range fields {
+		clone.Fields[field] = name
+	}
+
+	return clone
+}
+
+func (catalog *Catalog) clone() *Catalog {
+	clone := &Catalog{
+		Locale:   catalog.Locale,
+		Messages: make(map[string]string, len(catalog.Messages)),
+		Fields:   make(map[string]string, len(catalog.Fields)),
+	}
+
+	for key, template := range catalog.Messages {
+		clone.Messages[key] = template
+	}
+
+	for field, name := range catalog.Fields {
+		clone.Fields[field] = name
+	}
+
+	return clone
+}
+
+// Negotiate picks the catalog matching an Accept-Language header value, e.g. "ru-RU,ru;q=0.9,en;q=0.8".
+// The first catalog is returned when nothing matches.
+func Negotiate(acceptLanguage string, catalogs ...*Catalog) *Catalog {
+	if len(catalogs) == 0 {
+		return English
+	}
+
+	type language struct {
+		tag     string
+		quality float64
+	}
+
+	var languages []language
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag, parameters, _ := strings.Cut(strings.TrimSpace(part), ";")
+		if len(tag) == 0 {
+			continue
+		}
+
+		quality := 1.0
+		if value, found := strings.CutPrefix(strings.TrimSpace(parameters), "q="); found {
+			if parsed, err := strconv.ParseFloat(value, 64); err == nil {
+				quality = parsed
+			}
+		}
+
+		languages = append(languages, language{strings.ToLower(tag), quality})
+	}
+
+	sort.SliceStable(languages, func(i, j int) bool {
+		return languages[i].quality > languages[j].quality
+	})
+
+	for _, language := range languages {
+		primary, _, _ := strings.Cut(language.tag, "-")
+		for _, catalog := range catalogs {
+			if strings.EqualFold(catalog.Locale, language.tag) || strings.EqualFold(catalog.Locale, primary) {
+				return catalog
+			}
+		}
+	}
+
+	return catalogs[0]
+}
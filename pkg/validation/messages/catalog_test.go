@@ -0,0 +1,56 @@
+package messages
+
+import "testing"
+
+func TestCatalogTranslate(t *testing.T) {
+	russian := Russian.WithFields(map[string]string{
+		"title":        "название",
+		"steps.*.name": "название шага",
+		"password":     "пароль",
+	})
+
+	tableTests := []struct {
+		name    string
+		catalog *Catalog
+		message *Message
+		want    string
+	}{
+		{"English", English, New("max.string", "title", map[string]any{"limit": 255}), "The title field must not be greater than 255 characters"},
+		{"Russian", Russian, New("required", "title", nil), "Поле title обязательно для заполнения"},
+		{"Russian field name", russian, New("max.string", "title", map[string]any{"limit": 255}), "Количество символов в поле название не должно превышать 255"},
+		{"Wildcard field name", russian, New("required", "steps.3.name", nil), "Поле название шага обязательно для заполнения"},
+		{"Field parameter", russian, New("same", "password_confirmation", map[string]any{"other": Field("password")}), "Поле password_confirmation должно совпадать с полем пароль"},
+		{"Literal text", russian, &Message{Field: "title", Text: "Поле :field уже занято"}, "Поле название уже занято"},
+		{"Unknown key", English, New("The :field field is taken", "title", nil), "The title field is taken"},
+	}
+
+	for _, tt := range tableTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.catalog.Translate(tt.message); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNegotiate(t *testing.T) {
+	tableTests := []struct {
+		name           string
+		acceptLanguage string
+		want           *Catalog
+	}{
+		{"Empty header", "", English},
+		{"Exact match", "ru", Russian},
+		{"Region", "ru-RU,ru;q=0.9,en;q=0.8", Russian},
+		{"Quality", "en;q=0.5,ru;q=0.9", Russian},
+		{"Unsupported", "de-DE", English},
+	}
+
+	for _, tt := range tableTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Negotiate(tt.acceptLanguage, English, Russian); got != tt.want {
+				t.Errorf("got %q, want %q", got.Locale, tt.want.Locale)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package messages
+
+var English = &Catalog{
+	Locale: "en",
+	Messages: map[string]string{
+		"required":           "The :field field is required",
+		"max.string":         "The :field field must not be greater than :limit characters",
+		"max.numeric":        "The :field field must not be greater than :limit",
+		"max.array":          "The :field field must not have more than :limit items",
+		"min.string":         "The :field field must not be less than :limit characters",
+		"min.numeric":        "The :field field must not be less than :limit",
+		"min.array":          "The :field field must not have less than :limit items",
+		"date":               "The :field field format is invalid",
+		"regex":              "The :field field format is invalid",
+		"password.lowercase": "The :field field must contain at least one lower case letter",
+		"password.uppercase": "The :field field must contain at least one upper case letter",
+		"password.number":    "The :field field must contain at least one number",
+		"same":               "The :field field must match :other",
+		"required_if":        "The :field field is required when :other is :values",
+		"required_unless":    "The :field field is required unless :other is in :values",
+		"required_with":      "The :field field is required when :values is present",
+		"required_without":   "The :field field is required when :values is not present",
+		"between.numeric":    "The :field field must be between :min and :max",
+		"between.string":     "The :field field must be between :min and :max characters",
+		"between.array":      "The :field field must have between :min and :max items",
+		"in":                 "The selected :field is invalid",
+		"not_in":             "The selected :field is invalid",
+		"comparable":         "The :field field cannot be compared with :other",
+		"gt.numeric":         "The :field field must be greater than :other",
+		"gt.string":          "The :field field must be longer than :other",
+		"gt.array":           "The :field field must have more items than :other",
+		"gt.date":            "The :field field must be a date after :other",
+		"gte.numeric":        "The :field field must be greater than or equal to :other",
+		"gte.string":         "The :field field must not be shorter than :other",
+		"gte.array":          "The :field field must not have fewer items than :other",
+		"gte.date":           "The :field field must be a date after or equal to :other",
+		"lt.numeric":         "The :field field must be less than :other",
+		"lt.string":          "The :field field must be shorter than :other",
+		"lt.array":           "The :field field must have fewer items than :other",
+		"lt.date":            "The :field field must be a date before :other",
+		"lte.numeric":        "The :field field must be less than or equal to :other",
+		"lte.string":         "The :field field must not be longer than :other",
+		"lte.array":          "The :field field must not have more items than :other",
+		"lte.date":           "The :field field must be a date before or equal to :other",
+		"date_format":        "The :field field must match the format :format",
+		"date_time":          "The :field field must be a valid date and time",
+		"time":               "The :field field must be a valid time",
+		"before":             "The :field field must be a date before :date",
+		"before_or_equal":    "The :field field must be a date before or equal to :date",
+		"after":              "The :field field must be a date after :date",
+		"after_or_equal":     "The :field field must be a date after or equal to :date",
+		"unique":             "The :field has already been taken",
+		"exists":             "The selected :field is invalid",
+		"scalar":             "The :field field must be a string, a number or a boolean",
+		"each":               "The :field field has invalid items",
+		"distinct":           "The :field field has a duplicate value",
+		"not":                "The :field field is invalid",
+		"uuid":               "The :field field must be a valid UUID",
+		"url":                "The :field field must be a valid URL",
+		"ip":                 "The :field field must be a valid IP address",
+		"cidr":               "The :field field must be a valid network in the CIDR notation",
+		"phone":              "The :field field must be a valid phone number in the international format",
+		"timezone":           "The :field field must be a valid timezone",
+		"hex_color":          "The :field field must be a valid hexadecimal color",
+		"slug":               "The :field field must only contain lower case letters, numbers and dashes",
+		"json":               "The :field field must be a valid JSON string",
+		"alpha":              "The :field field must only contain letters",
+		"alpha_num":          "The :field field must only contain letters and numbers",
+		"san":                "The :field field must only contain letters, numbers and spaces",
+		"sand":               "The :field field must only contain letters, numbers, spaces and dashes",
+		"password.symbol":    "The :field field must contain at least one symbol",
+		"password.banned":    "The :field field must not be a commonly used password",
+		"password.personal":  "The :field field must not contain the :other",
+		"password.entropy":   "The :field field is too easy to guess",
+		"at_least_one_of":    "At least one of the :values fields is required",
+		"only_one_of":        "The :field field is prohibited when :other is present",
+		"integer":            "The :field field must be an integer",
+		"numeric":            "The :field field must be a number",
+		"decimal":            "The :field field must be a number with at most :places decimal places",
+		"multiple_of":        "The :field field must be a multiple of :value",
+		"positive":           "The :field field must be greater than 0",
+		"non_negative":       "The :field field must not be negative",
+		"file":               "The :field field must be a file",
+		"max_file_size":      "The :field field must not be greater than :size",
+		"mime_types":         "The :field field must be a file of type: :values",
+		"extensions":         "The :field field must have one of the following extensions: :values",
+		"image":              "The :field field must be an image",
+		"image.width":        "The :field field must not be wider than :width pixels",
+		"image.height":       "The :field field must not be higher than :height pixels",
+		"boolean":            "The :field field must be true or false",
+		"password":           "The :field field must be a valid password",
+	},
+	Fields: map[string]string{},
+}
@@ -0,0 +1,31 @@
+package messages
+
+// Field is a message parameter that names another field. Translators render it with the field's display name.
+type Field string
+
+// Message is a validation failure described by a catalog key and its parameters.
+// Placeholders in catalog entries are written as :name, with :field standing for the validated field.
+type Message struct {
+	Key      string
+	Field    string
+	Params   map[string]any
+	Text     string     // a literal template used instead of the catalog entry
+	Children []*Message // failures of nested values reported under their own fields, e.g. "tags.2"
+}
+
+func New(key string, field string, params map[string]any) *Message {
+	return &Message{Key: key, Field: field, Params: params}
+}
+
+// String renders the message in English.
+func (message *Message) String() string {
+	if message == nil {
+		return ""
+	}
+
+	return English.Translate(message)
+}
+
+type Translator interface {
+	Translate(message *Message) string
+}
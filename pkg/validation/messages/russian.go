@@ -0,0 +1,94 @@
+package messages
+
+var Russian = &Catalog{
+	Locale: "ru",
+	Messages: map[string]string{
+		"required":           "Поле :field обязательно для заполнения",
+		"max.string":         "Количество символов в поле :field не должно превышать :limit",
+		"max.numeric":        "Значение поля :field не должно быть больше :limit",
+		"max.array":          "Количество элементов в поле :field не должно превышать :limit",
+		"min.string":         "Количество символов в поле :field не должно быть меньше :limit",
+		"min.numeric":        "Значение поля :field не должно быть меньше :limit",
+		"min.array":          "Количество элементов в поле :field не должно быть меньше :limit",
+		"date":               "Поле :field имеет неверный формат",
+		"regex":              "Поле :field имеет неверный формат",
+		"password.lowercase": "Поле :field должно содержать хотя бы одну строчную букву",
+		"password.uppercase": "Поле :field должно содержать хотя бы одну заглавную букву",
+		"password.number":    "Поле :field должно содержать хотя бы одну цифру",
+		"same":               "Поле :field должно совпадать с полем :other",
+		"required_if":        "Поле :field обязательно для заполнения, когда поле :other равно :values",
+		"required_unless":    "Поле :field обязательно для заполнения, когда поле :other не равно :values",
+		"required_with":      "Поле :field обязательно для заполнения, когда заполнено :values",
+		"required_without":   "Поле :field обязательно для заполнения, когда не заполнено :values",
+		"between.numeric":    "Значение поля :field должно быть между :min и :max",
+		"between.string":     "Количество символов в поле :field должно быть между :min и :max",
+		"between.array":      "Количество элементов в поле :field должно быть между :min и :max",
+		"in":                 "Выбранное значение поля :field недопустимо",
+		"not_in":             "Выбранное значение поля :field недопустимо",
+		"comparable":         "Поле :field нельзя сравнить с полем :other",
+		"gt.numeric":         "Значение поля :field должно быть больше значения поля :other",
+		"gt.string":          "Поле :field должно быть длиннее поля :other",
+		"gt.array":           "Количество элементов в поле :field должно быть больше, чем в поле :other",
+		"gt.date":            "Поле :field должно быть датой позже :other",
+		"gte.numeric":        "Значение поля :field должно быть больше или равно значению поля :other",
+		"gte.string":         "Поле :field не должно быть короче поля :other",
+		"gte.array":          "Количество элементов в поле :field не должно быть меньше, чем в поле :other",
+		"gte.date":           "Поле :field должно быть датой не раньше :other",
+		"lt.numeric":         "Значение поля :field должно быть меньше значения поля :other",
+		"lt.string":          "Поле :field должно быть короче поля :other",
+		"lt.array":           "Количество элементов в поле :field должно быть меньше, чем в поле :other",
+		"lt.date":            "Поле :field должно быть датой раньше :other",
+		"lte.numeric":        "Значение поля :field должно быть меньше или равно значению поля :other",
+		"lte.string":         "Поле :field не должно быть длиннее поля :other",
+		"lte.array":          "Количество элементов в поле :field не должно быть больше, чем в поле :other",
+		"lte.date":           "Поле :field должно быть датой не позже :other",
+		"date_format":        "Поле :field должно соответствовать формату :format",
+		"date_time":          "Поле :field должно быть корректной датой и временем",
+		"time":               "Поле :field должно быть корректным временем",
+		"before":             "Поле :field должно быть датой раньше :date",
+		"before_or_equal":    "Поле :field должно быть датой не позже :date",
+		"after":              "Поле :field должно быть датой позже :date",
+		"after_or_equal":     "Поле :field должно быть датой не раньше :date",
+		"unique":             "Значение поля :field уже занято",
+		"exists":             "Выбранное значение поля :field не существует",
+		"scalar":             "Поле :field должно быть строкой, числом или логическим значением",
+		"each":               "Поле :field содержит недопустимые элементы",
+		"distinct":           "Поле :field содержит повторяющиеся значения",
+		"not":                "Поле :field имеет недопустимое значение",
+		"uuid":               "Поле :field должно быть корректным UUID",
+		"url":                "Поле :field должно быть корректным URL",
+		"ip":                 "Поле :field должно быть корректным IP-адресом",
+		"cidr":               "Поле :field должно быть корректной сетью в нотации CIDR",
+		"phone":              "Поле :field должно быть корректным номером телефона в международном формате",
+		"timezone":           "Поле :field должно быть корректным часовым поясом",
+		"hex_color":          "Поле :field должно быть корректным шестнадцатеричным цветом",
+		"slug":               "Поле :field может содержать только строчные латинские буквы, цифры и дефисы",
+		"json":               "Поле :field должно быть корректной JSON-строкой",
+		"alpha":              "Поле :field может содержать только буквы",
+		"alpha_num":          "Поле :field может содержать только буквы и цифры",
+		"san":                "Поле :field может содержать только буквы, цифры и пробелы",
+		"sand":               "Поле :field может содержать только буквы, цифры, пробелы и дефисы",
+		"password.symbol":    "Поле :field должно содержать хотя бы один специальный символ",
+		"password.banned":    "Поле :field не должно быть распространённым паролем",
+		"password.personal":  "Поле :field не должно содержать :other",
+		"password.entropy":   "Поле :field слишком простое",
+		"at_least_one_of":    "Необходимо заполнить хотя бы одно из полей :values",
+		"only_one_of":        "Поле :field запрещено, если указано поле :other",
+		"integer":            "Поле :field должно быть целым числом",
+		"numeric":            "Поле :field должно быть числом",
+		"decimal":            "Поле :field должно быть числом, содержащим не более :places знаков после запятой",
+		"multiple_of":        "Поле :field должно быть кратно :value",
+		"positive":           "Поле :field должно быть больше 0",
+		"non_negative":       "Поле :field не должно быть отрицательным",
+		"file":               "Поле :field должно быть файлом",
+		"max_file_size":      "Размер файла в поле :field не должен превышать :size",
+		"mime_types":         "Поле :field должно быть файлом одного из типов: :values",
+		"extensions":         "Поле :field должно иметь одно из расширений: :values",
+		"image":              "Поле :field должно быть изображением",
+		"image.width":        "Ширина изображения в поле :field не должна превышать :width пикселей",
+		"image.height":       "Высота изображения в поле :field не должна превышать :height пикселей",
+		"boolean":            "Поле :field должно быть логическим значением",
+		"password":           "Поле :field должно быть корректным паролем",
+	},
+	Fields: map[string]string{},
+}
@@ -0,0 +1,83 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"github.com/EugeneNail/motivatr-lib-common/pkg/validation/rules"
+	"net/http"
+	"strings"
+)
+
+const (
+	// DefaultMaxFormSize limits multipart bodies when no other limit is given.
+	DefaultMaxFormSize int64 = 32 << 20
+	// formMemory is the part of a multipart body kept in memory, the rest of the files is stored in temporary files.
+	formMemory int64 = 10 << 20
+	listSuffix       = "[]"
+)
+
+// DecodeMultipartForm parses a multipart body of at most maxBytes bytes (DefaultMaxFormSize if not positive) into
+// data for the rules. Values become strings and files become *multipart.FileHeader values checked by rules.File
+// and the other file rules. Fields named with the [] suffix, e.g. "tags[]" or "attachments[]", become []any
+// under the name without the suffix, so that they are validated with wildcards or rules.Each. Otherwise the
+// first value of a repeated field is used. Call request.MultipartForm.RemoveAll when the files are no longer needed.
+func DecodeMultipartForm(request *http.Request, maxBytes int64) (map[string]any, error) {
+	if maxBytes <= 0 {
+		maxBytes = DefaultMaxFormSize
+	}
+
+	request.Body = http.MaxBytesReader(nil, request.Body, maxBytes)
+	if err := request.ParseMultipartForm(formMemory); err != nil {
+		return nil, fmt.Errorf("parsing the multipart form: %w", err)
+	}
+
+	data := make(map[string]any)
+	for name, values := range request.MultipartForm.Value {
+		if list, isList := strings.CutSuffix(name, listSuffix); isList {
+			for _, value := range values {
+				data[list] = appendItem(data[list], value)
+			}
+
+			continue
+		}
+
+		data[name] = values[0]
+	}
+
+	for name, files := range request.MultipartForm.File {
+		if list, isList := strings.CutSuffix(name, listSuffix); isList {
+			for _, file := range files {
+				data[list] = appendItem(data[list], file)
+			}
+
+			continue
+		}
+
+		data[name] = files[0]
+	}
+
+	return data, nil
+}
+
+func appendItem(list any, item any) []any {
+	items, _ := list.([]any)
+	return append(items, item)
+}
+
+// ValidateMultipartForm decodes the request body with DecodeMultipartForm and validates it. The status and the
+// response follow ValidateJsonBody: 400 or 413 with an error when the body cannot be decoded, 422 with the
+// validation errors when the data is invalid.
+func ValidateMultipartForm(request *http.Request, maxBytes int64, ruleFuncs map[string][]rules.Rule, options ...Option) (data map[string]any, status int, response any) {
+	data, err := DecodeMultipartForm(request, maxBytes)
+
+	var maxBytesError *http.MaxBytesError
+	if errors.As(err, &maxBytesError) {
+		return nil, http.StatusRequestEntityTooLarge, err
+	}
+
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+
+	return validateData(request.Context(), NewValidator(data, ruleFuncs, options...))
+}
@@ -0,0 +1,107 @@
+package validation
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/EugeneNail/motivatr-lib-common/pkg/validation/rules"
+)
+
+func TestValidateMultipartForm(t *testing.T) {
+	ruleFuncs := map[string][]rules.Rule{
+		"title":       {rules.Required(), rules.Max(10)},
+		"tags":        {rules.Each(rules.Slug())},
+		"avatar":      {rules.Required(), rules.File(), rules.MaxFileSize(16)},
+		"attachments": {rules.Max(2), rules.Each(rules.Extensions("txt"))},
+	}
+
+	tableTests := []struct {
+		name       string
+		fields     map[string][]string
+		files      map[string][]string
+		maxBytes   int64
+		wantStatus int
+		wantErrors map[string]string
+	}{
+		{
+			"Valid form",
+			map[string][]string{"title": {"Read"}, "tags[]": {"books", "reading"}},
+			map[string][]string{"avatar": {"avatar.png"}, "attachments[]": {"notes.txt", "plan.txt"}},
+			0,
+			http.StatusOK,
+			nil,
+		},
+		{
+			"Invalid form",
+			map[string][]string{"title": {"Nostradamus"}, "tags[]": {"Books"}},
+			map[string][]string{"attachments[]": {"notes.txt", "plan.pdf"}},
+			0,
+			http.StatusUnprocessableEntity,
+			map[string]string{
+				"title":         "The title field must not be greater than 10 characters",
+				"tags.0":        "The tags.0 field must only contain lower case letters, numbers and dashes",
+				"avatar":        "The avatar field is required",
+				"attachments.1": "The attachments.1 field must have one of the following extensions: txt",
+			},
+		},
+		{
+			"Too large form",
+			map[string][]string{"title": {"Read"}},
+			map[string][]string{"avatar": {"avatar.png"}},
+			64,
+			http.StatusRequestEntityTooLarge,
+			nil,
+		},
+	}
+
+	for _, tt := range tableTests {
+		t.Run(tt.name, func(t *testing.T) {
+			var body bytes.Buffer
+			writer := multipart.NewWriter(&body)
+			for name, values := range tt.fields {
+				for _, value := range values {
+					writer.WriteField(name, value)
+				}
+			}
+
+			for name, fileNames := range tt.files {
+				for _, fileName := range fileNames {
+					part, _ := writer.CreateFormFile(name, fileName)
+					part.Write([]byte("content"))
+				}
+			}
+
+			writer.Close()
+
+			request := httptest.NewRequest(http.MethodPost, "/goals", &body)
+			request.Header.Set("Content-Type", writer.FormDataContentType())
+
+			data, status, response := ValidateMultipartForm(request, tt.maxBytes, ruleFuncs)
+			if status != tt.wantStatus {
+				t.Fatalf("got status %d, want %d (response %v)", status, tt.wantStatus, response)
+			}
+
+			if status == http.StatusOK && (response != nil || data["title"] != "Read" || len(data["attachments"].([]any)) != 2) {
+				t.Errorf("got data %v and response %v", data, response)
+			}
+
+			if tt.wantErrors == nil {
+				return
+			}
+
+			errors := response.(map[string]string)
+			if len(errors) != len(tt.wantErrors) {
+				t.Fatalf("got %v, want %v", errors, tt.wantErrors)
+			}
+
+			for field, message := range tt.wantErrors {
+				if errors[field] != message {
+					t.Errorf("%s: got %q, want %q", field, errors[field], message)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,204 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"github.com/EugeneNail/motivatr-lib-common/pkg/validation/messages"
+	"github.com/EugeneNail/motivatr-lib-common/pkg/validation/rules"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParamType converts the text of a query or a path parameter into the value seen by the rules.
+// The key is the message reported when the text cannot be converted, e.g. "integer" for "?page=two".
+type ParamType struct {
+	parse func(raw string) (any, error)
+	key   string
+	item  *ParamType
+}
+
+var (
+	StringParam = ParamType{parse: func(raw string) (any, error) {
+		return raw, nil
+	}}
+	IntParam = ParamType{key: "integer", parse: func(raw string) (any, error) {
+		return strconv.ParseInt(raw, 10, 64)
+	}}
+	FloatParam = ParamType{key: "numeric", parse: func(raw string) (any, error) {
+		float, err := strconv.ParseFloat(raw, 64)
+		if err == nil && (math.IsInf(float, 0) || math.IsNaN(float)) {
+			return nil, fmt.Errorf("%q is not a finite number", raw)
+		}
+
+		return float, err
+	}}
+	BoolParam = ParamType{key: "boolean", parse: func(raw string) (any, error) {
+		return strconv.ParseBool(raw)
+	}}
+	// DateParam accepts 2006-01-02 dates and produces time.Time values accepted by rules.Before and rules.After.
+	DateParam = ParamType{key: "date", parse: func(raw string) (any, error) {
+		return time.Parse(time.DateOnly, raw)
+	}}
+)
+
+// ListParam collects the items of repeated and comma separated parameters into a []any,
+// e.g. "?tag=books,reading&tag=sports" yields three strings for ListParam(StringParam).
+func ListParam(item ParamType) ParamType {
+	return ParamType{item: &item}
+}
+
+type paramSource int
+
+const (
+	querySource paramSource = iota
+	pathSource
+)
+
+// Param declares a query or a path parameter with its type and rules.
+type Param struct {
+	Name   string
+	Type   ParamType
+	Rules  []rules.Rule
+	source paramSource
+}
+
+// Query declares a parameter of the query string, e.g. Query("page", IntParam, rules.Min(1)).
+func Query(name string, paramType ParamType, ruleFuncs ...rules.Rule) Param {
+	return Param{Name: name, Type: paramType, Rules: ruleFuncs, source: querySource}
+}
+
+// Path declares a wildcard of the route pattern read with request.PathValue, e.g. Path("id", IntParam) for "/goals/{id}".
+func Path(name string, paramType ParamType, ruleFuncs ...rules.Rule) Param {
+	return Param{Name: name, Type: paramType, Rules: ruleFuncs, source: pathSource}
+}
+
+// paramFailure is a parameter or an item of a list parameter that cannot be converted, e.g. "tags.2".
+type paramFailure struct {
+	field string
+	key   string
+	err   error
+}
+
+// DecodeParams converts the parameters of the request into data for the rules. Absent parameters are left out
+// and empty ones become null, so that rules.Required, rules.Sometimes and rules.Nullable work as for json bodies.
+// The error joins the failures of every parameter that cannot be converted.
+func DecodeParams(request *http.Request, params ...Param) (map[string]any, error) {
+	data, failures := decodeParams(request, params)
+	if len(failures) > 0 {
+		errs := make([]error, len(failures))
+		for i, failure := range failures {
+			errs[i] = fmt.Errorf("converting the %s parameter: %w", failure.field, failure.err)
+		}
+
+		return nil, errors.Join(errs...)
+	}
+
+	return data, nil
+}
+
+// decodeParams leaves the parameters that cannot be converted out of the data.
+func decodeParams(request *http.Request, params []Param) (map[string]any, []paramFailure) {
+	query := request.URL.Query()
+	data := make(map[string]any, len(params))
+
+	var failures []paramFailure
+	for _, param := range params {
+		var raws []string
+		if param.source == pathSource {
+			if raw := request.PathValue(param.Name); len(raw) > 0 {
+				raws = []string{raw}
+			}
+		} else {
+			raws = query[param.Name]
+		}
+
+		if len(raws) == 0 {
+			continue
+		}
+
+		value, paramFailures := param.Type.convert(param.Name, raws)
+		if len(paramFailures) > 0 {
+			failures = append(failures, paramFailures...)
+			continue
+		}
+
+		data[param.Name] = value
+	}
+
+	return data, failures
+}
+
+func (paramType ParamType) convert(field string, raws []string) (any, []paramFailure) {
+	if paramType.item == nil {
+		if len(raws[0]) == 0 {
+			return nil, nil
+		}
+
+		value, err := paramType.parse(raws[0])
+		if err != nil {
+			return nil, []paramFailure{{field, paramType.key, err}}
+		}
+
+		return value, nil
+	}
+
+	var failures []paramFailure
+	items := make([]any, 0, len(raws))
+	for _, raw := range raws {
+		for _, itemRaw := range strings.Split(raw, ",") {
+			if len(itemRaw) == 0 {
+				continue
+			}
+
+			item, err := paramType.item.parse(itemRaw)
+			if err != nil {
+				itemField := field + pathSeparator + strconv.Itoa(len(items))
+				failures = append(failures, paramFailure{itemField, paramType.item.key, err})
+			}
+
+			items = append(items, item)
+		}
+	}
+
+	return items, failures
+}
+
+// ValidateParams decodes the parameters like DecodeParams and validates them in the order of declaration.
+// The status and the response follow ValidateJsonBody: 422 with the validation errors when the data is invalid,
+// including the parameters that cannot be converted, e.g. "The page field must be an integer" for "?page=two".
+// The rules of such parameters are not run.
+//
+//	data, status, response := validation.ValidateParams(request, []validation.Param{
+//		validation.Query("page", validation.IntParam, rules.Min(1)),
+//		validation.Query("limit", validation.IntParam, rules.Between(1, 100)),
+//		validation.Query("from", validation.DateParam, rules.Before("today")),
+//		validation.Path("id", validation.IntParam, rules.Required()),
+//	})
+//	if response != nil {
+//		return status, response
+//	}
+func ValidateParams(request *http.Request, params []Param, options ...Option) (data map[string]any, status int, response any) {
+	data, failures := decodeParams(request, params)
+
+	failed := make(map[string]bool, len(failures))
+	for _, failure := range failures {
+		failed[strings.SplitN(failure.field, pathSeparator, 2)[0]] = true
+	}
+
+	fieldRules := make([]FieldRules, 0, len(params))
+	for _, param := range params {
+		if !failed[param.Name] {
+			fieldRules = append(fieldRules, FieldRules{Field: param.Name, Rules: param.Rules})
+		}
+	}
+
+	validator := NewOrderedValidator(data, fieldRules, options...)
+	for _, failure := range failures {
+		validator.AddMessage(messages.New(failure.key, failure.field, nil))
+	}
+
+	return validateData(request.Context(), validator)
+}
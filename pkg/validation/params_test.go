@@ -0,0 +1,123 @@
+package validation
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/EugeneNail/motivatr-lib-common/pkg/validation/rules"
+)
+
+func TestValidateParams(t *testing.T) {
+	params := []Param{
+		Query("page", IntParam, rules.Min(1)),
+		Query("limit", IntParam, rules.Between(1, 100)),
+		Query("price", FloatParam, rules.Decimal(2)),
+		Query("archived", BoolParam),
+		Query("from", DateParam, rules.Nullable(), rules.Before("2026-01-01")),
+		Query("tags", ListParam(StringParam), rules.Max(3), rules.Each(rules.Slug())),
+		Query("ids", ListParam(IntParam), rules.Max(2)),
+		Path("id", IntParam, rules.Required(), rules.Positive()),
+	}
+
+	tableTests := []struct {
+		name       string
+		target     string
+		id         string
+		wantStatus int
+		want       any
+	}{
+		{
+			"Valid parameters",
+			"/goals?page=2&limit=50&price=9.99&archived=true&from=2025-01-01&tags=books,reading&tags=sports",
+			"7",
+			http.StatusOK,
+			map[string]any{
+				"page":     int64(2),
+				"limit":    int64(50),
+				"price":    9.99,
+				"archived": true,
+				"from":     time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+				"tags":     []any{"books", "reading", "sports"},
+				"id":       int64(7),
+			},
+		},
+		{
+			"Absent and empty parameters",
+			"/goals?from=",
+			"7",
+			http.StatusOK,
+			map[string]any{"from": nil, "id": int64(7)},
+		},
+		{
+			"Invalid values",
+			"/goals?page=0&limit=500&tags=a,b,c,d",
+			"-1",
+			http.StatusUnprocessableEntity,
+			map[string]string{
+				"page":  "The page field must not be less than 1",
+				"limit": "The limit field must be between 1 and 100",
+				"tags":  "The tags field must not have more than 3 items",
+				"id":    "The id field must be greater than 0",
+			},
+		},
+		{"Missing path value", "/goals", "", http.StatusUnprocessableEntity, map[string]string{"id": "The id field is required"}},
+		{"Non-integer page", "/goals?page=two", "7", http.StatusUnprocessableEntity, map[string]string{"page": "The page field must be an integer"}},
+		{"Invalid date", "/goals?from=01.01.2025", "7", http.StatusUnprocessableEntity, map[string]string{"from": "The from field format is invalid"}},
+		{"Non-integer path value", "/goals?tags=books&tags=,", "x", http.StatusUnprocessableEntity, map[string]string{"id": "The id field must be an integer"}},
+		{
+			"Several parameters that cannot be converted",
+			"/goals?page=abc&price=cheap&archived=maybe&ids=1,two,3,four",
+			"7",
+			http.StatusUnprocessableEntity,
+			map[string]string{
+				"page":     "The page field must be an integer",
+				"price":    "The price field must be a number",
+				"archived": "The archived field must be true or false",
+				"ids.1":    "The ids.1 field must be an integer",
+				"ids.3":    "The ids.3 field must be an integer",
+			},
+		},
+	}
+
+	for _, tt := range tableTests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := httptest.NewRequest(http.MethodGet, tt.target, nil)
+			request.SetPathValue("id", tt.id)
+
+			data, status, response := ValidateParams(request, params)
+			if status != tt.wantStatus {
+				t.Fatalf("got status %d, want %d (response %v)", status, tt.wantStatus, response)
+			}
+
+			switch status {
+			case http.StatusOK:
+				if response != nil || !reflect.DeepEqual(data, tt.want) {
+					t.Errorf("got data %v and response %v, want %v", data, response, tt.want)
+				}
+			case http.StatusUnprocessableEntity:
+				if !reflect.DeepEqual(response, tt.want) {
+					t.Errorf("got %v, want %v", response, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestDecodeParams(t *testing.T) {
+	request := httptest.NewRequest(http.MethodGet, "/goals?page=abc&limit=10&archived=maybe", nil)
+
+	data, err := DecodeParams(request, Query("page", IntParam), Query("limit", IntParam), Query("archived", BoolParam))
+	if err == nil {
+		t.Fatalf("got data %v, want an error", data)
+	}
+
+	for _, parameter := range []string{"page", "archived"} {
+		if !strings.Contains(err.Error(), "converting the "+parameter+" parameter") {
+			t.Errorf("got %q, want the %s parameter", err, parameter)
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package validation
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	pathSeparator = "."
+	wildcard      = "*"
+)
+
+// flatten returns a copy of the data where every nested map and slice element is also available
+// under its dotted path, e.g. "goal.title" or "steps.3.name". Top-level keys are kept as they are.
+func flatten(data map[string]any) map[string]any {
+	flat := make(map[string]any, len(data))
+	for key, value := range data {
+		flat[key] = value
+	}
+
+	for key, value := range data {
+		flattenInto(flat, key, value)
+	}
+
+	return flat
+}
+
+func flattenInto(flat map[string]any, prefix string, value any) {
+	for _, child := range children(value) {
+		path := prefix + pathSeparator + child.key
+		if _, exists := flat[path]; !exists {
+			flat[path] = child.value
+		}
+
+		flattenInto(flat, path, child.value)
+	}
+}
+
+type child struct {
+	key   string
+	value any
+}
+
+// children lists the elements of a slice, an array or a map in a stable order.
+func children(value any) []child {
+	if value == nil {
+		return nil
+	}
+
+	reflected := reflect.ValueOf(value)
+
+	switch reflected.Kind() {
+	case reflect.Slice, reflect.Array:
+		result := make([]child, 0, reflected.Len())
+		for i := 0; i < reflected.Len(); i++ {
+			result = append(result, child{strconv.Itoa(i), reflected.Index(i).Interface()})
+		}
+
+		return result
+	case reflect.Map:
+		result := make([]child, 0, reflected.Len())
+		iterator := reflected.MapRange()
+		for iterator.Next() {
+			result = append(result, child{fmt.Sprint(iterator.Key().Interface()), iterator.Value().Interface()})
+		}
+
+		sort.Slice(result, func(i, j int) bool {
+			return result[i].key < result[j].key
+		})
+
+		return result
+	}
+
+	return nil
+}
+
+// expandPath resolves wildcard segments of the path against the flattened data.
+// A path like "steps.*.name" becomes "steps.0.name", "steps.1.name" and so on.
+// A path without wildcards is returned as it is, whether it exists or not.
+func expandPath(path string, flat map[string]any) []string {
+	if !strings.Contains(path, wildcard) {
+		return []string{path}
+	}
+
+	prefixes := []string{""}
+
+	for _, segment := range strings.Split(path, pathSeparator) {
+		var next []string
+
+		for _, prefix := range prefixes {
+			if segment != wildcard {
+				next = append(next, joinPath(prefix, segment))
+				continue
+			}
+
+			value, exists := flat[prefix]
+			if !exists {
+				continue
+			}
+
+			for _, child := range children(value) {
+				next = append(next, joinPath(prefix, child.key))
+			}
+		}
+
+		prefixes = next
+	}
+
+	return prefixes
+}
+
+// matchesPath reports whether the concrete path matches the pattern, e.g. "steps.3.name" matches "steps.*.name".
+func matchesPath(pattern string, path string) bool {
+	patternSegments := strings.Split(pattern, pathSeparator)
+	pathSegments := strings.Split(path, pathSeparator)
+	if len(patternSegments) != len(pathSegments) {
+		return false
+	}
+
+	for i, segment := range patternSegments {
+		if segment != wildcard && segment != pathSegments[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func joinPath(prefix string, segment string) string {
+	if len(prefix) == 0 {
+		return segment
+	}
+
+	return prefix + pathSeparator + segment
+}
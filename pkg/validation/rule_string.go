@@ -0,0 +1,422 @@
+package validation
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/EugeneNail/motivatr-lib-common/pkg/validation/rules"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+)
+
+// RuleFactory builds a rule from the arguments of a rule string entry, e.g. ["daily", "weekly"] for "in:daily,weekly".
+type RuleFactory func(arguments []string) (rules.Rule, error)
+
+var registry = struct {
+	sync.RWMutex
+	factories map[string]RuleFactory
+}{factories: builtinFactories()}
+
+// RegisterRule makes the rule available to ParseRules under the name, replacing the rule previously registered
+// under it. It is safe to call concurrently, e.g. from the init functions of several packages.
+func RegisterRule(name string, factory RuleFactory) {
+	registry.Lock()
+	defer registry.Unlock()
+
+	registry.factories[name] = factory
+}
+
+// ParseRules builds the rules declared by a string like "required|max:255|regex:^[a-z]+$|in:daily,weekly".
+// Entries are separated by pipes and their arguments by commas. Escaped pipes and pipes inside parentheses and brackets
+// belong to the argument, so the alternatives of a regex must be grouped, e.g. "regex:^(daily|weekly)$".
+// Unbalanced parentheses and brackets are an error, escape them to use them literally, e.g. "regex:^\\($".
+//
+// Values of in, not_in, required_if and required_unless are kept as strings, which also match numbers and booleans
+// with the same text, so that "in:1,2,3" accepts both the numbers decoded from json and the strings of forms.
+// Dates of before and after may be field names and may be followed by a location, e.g. "before:today,Europe/Moscow".
+// The unicode alphabet rules take script names, e.g. "unicode_alpha:Cyrillic,Latin".
+func ParseRules(spec string) ([]rules.Rule, error) {
+	var ruleFuncs []rules.Rule
+
+	entries, err := splitRuleString(spec)
+	if err != nil {
+		return nil, fmt.Errorf("splitting the rules: %w", err)
+	}
+
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if len(entry) == 0 {
+			continue
+		}
+
+		name, argument, hasArgument := strings.Cut(entry, ":")
+
+		var arguments []string
+		switch {
+		case hasArgument && wholeArgumentRules[name]:
+			arguments = []string{argument}
+		case hasArgument:
+			arguments = strings.Split(argument, ",")
+		}
+
+		ruleFunc, err := buildRule(name, arguments)
+		if err != nil {
+			return nil, err
+		}
+
+		ruleFuncs = append(ruleFuncs, ruleFunc)
+	}
+
+	return ruleFuncs, nil
+}
+
+// wholeArgumentRules take their argument with commas and spaces, e.g. the pattern of "regex:^[a-z]{1,3}$".
+var wholeArgumentRules = map[string]bool{"regex": true, "date_format": true}
+
+// buildRule looks up the factory of the rule in the registry.
+func buildRule(name string, arguments []string) (rules.Rule, error) {
+	registry.RLock()
+	factory, exists := registry.factories[name]
+	registry.RUnlock()
+
+	if !exists {
+		return nil, fmt.Errorf("unknown rule %q", name)
+	}
+
+	ruleFunc, err := factory(arguments)
+	if err != nil {
+		return nil, fmt.Errorf("parsing the %s rule: %w", name, err)
+	}
+
+	return ruleFunc, nil
+}
+
+// ParseRuleSet builds the rules of every field with ParseRules, e.g. from a config file shared with the frontend.
+func ParseRuleSet(specs map[string]string) (map[string][]rules.Rule, error) {
+	ruleFuncs := make(map[string][]rules.Rule, len(specs))
+	for field, spec := range specs {
+		fieldRuleFuncs, err := ParseRules(spec)
+		if err != nil {
+			return nil, fmt.Errorf("parsing the rules of %s: %w", field, err)
+		}
+
+		ruleFuncs[field] = fieldRuleFuncs
+	}
+
+	return ruleFuncs, nil
+}
+
+// splitRuleString splits the spec on the pipes outside of parentheses and brackets, skipping escaped characters.
+// Characters inside brackets are literal like in a regex character class, e.g. the parenthesis of "regex:^[(]$".
+func splitRuleString(spec string) ([]string, error) {
+	var entries []string
+
+	depth, start, bracket := 0, 0, -1
+	for i := 0; i < len(spec); i++ {
+		switch {
+		case spec[i] == '\\':
+			i++
+		case bracket >= 0:
+			if spec[i] == ']' {
+				bracket = -1
+			}
+		case spec[i] == '[':
+			bracket = i
+		case spec[i] == '(':
+			depth++
+		case spec[i] == ')' || spec[i] == ']':
+			if spec[i] == ')' && depth > 0 {
+				depth--
+				continue
+			}
+
+			return nil, fmt.Errorf("unmatched %q at position %d", spec[i], i)
+		case spec[i] == '|' && depth == 0:
+			entries = append(entries, spec[start:i])
+			start = i + 1
+		}
+	}
+
+	if bracket >= 0 {
+		return nil, fmt.Errorf("unclosed '[' at position %d", bracket)
+	}
+
+	if depth > 0 {
+		return nil, fmt.Errorf("unclosed '(' in %q", spec)
+	}
+
+	return append(entries, spec[start:]), nil
+}
+
+func builtinFactories() map[string]RuleFactory {
+	return map[string]RuleFactory{
+		"required":          withoutArguments(rules.Required),
+		"sometimes":         withoutArguments(rules.Sometimes),
+		"nullable":          withoutArguments(rules.Nullable),
+		"date":              withoutArguments(rules.Date),
+		"date_time":         withoutArguments(rules.DateTime),
+		"time":              withoutArguments(rules.Time),
+		"password":          withoutArguments(rules.Password),
+		"distinct":          withoutArguments(rules.Distinct),
+		"ip":                withoutArguments(rules.IP),
+		"cidr":              withoutArguments(rules.CIDR),
+		"phone":             withoutArguments(rules.Phone),
+		"timezone":          withoutArguments(rules.Timezone),
+		"hex_color":         withoutArguments(rules.HexColor),
+		"slug":              withoutArguments(rules.Slug),
+		"json":              withoutArguments(rules.JSON),
+		"unicode_alpha":     withScripts(rules.UnicodeAlpha),
+		"unicode_alpha_num": withScripts(rules.UnicodeAlphaNum),
+		"unicode_san":       withScripts(rules.UnicodeSan),
+		"unicode_sand":      withScripts(rules.UnicodeSand),
+		"email":             withoutArguments(func() rules.Rule { return rules.Regex(rules.Email) }),
+		"alpha":             withoutArguments(func() rules.Rule { return rules.Regex(rules.Alpha) }),
+		"alpha_num":         withoutArguments(func() rules.Rule { return rules.Regex(rules.AlphaNum) }),
+		"san":               withoutArguments(func() rules.Rule { return rules.Regex(rules.San) }),
+		"sand":              withoutArguments(func() rules.Rule { return rules.Regex(rules.Sand) }),
+		"max":               withLimit(rules.Max, rules.Max),
+		"min":               withLimit(rules.Min, rules.Min),
+		"between":           between,
+		"multiple_of":       multipleOf,
+		"integer":           withoutArguments(rules.Integer),
+		"numeric":           withoutArguments(rules.Numeric),
+		"positive":          withoutArguments(rules.Positive),
+		"non_negative":      withoutArguments(rules.NonNegative),
+		"decimal":           withInteger(rules.Decimal),
+		"same":              withString(rules.Same),
+		"gt":                withString(rules.GreaterThanField),
+		"gte":               withString(rules.GreaterThanOrEqualField),
+		"lt":                withString(rules.LessThanField),
+		"lte":               withString(rules.LessThanOrEqualField),
+		"before":            withDate(rules.BeforeIn),
+		"before_or_equal":   withDate(rules.BeforeOrEqualIn),
+		"after":             withDate(rules.AfterIn),
+		"after_or_equal":    withDate(rules.AfterOrEqualIn),
+		"date_format":       withWholeArgument(func(layout string) (rules.Rule, error) { return rules.DateFormat(layout), nil }),
+		"regex":             withWholeArgument(regex),
+		"in":                withValues(rules.In),
+		"not_in":            withValues(rules.NotIn),
+		"required_if":       withFieldAndValues(rules.RequiredIf),
+		"required_unless":   withFieldAndValues(rules.RequiredUnless),
+		"required_with":     withStrings(rules.RequiredWith),
+		"required_without":  withStrings(rules.RequiredWithout),
+		"url":               withOptionalStrings(rules.URL),
+		"uuid":              uuid,
+	}
+}
+
+func withoutArguments(constructor func() rules.Rule) RuleFactory {
+	return func(arguments []string) (rules.Rule, error) {
+		if len(arguments) > 0 {
+			return nil, fmt.Errorf("the rule takes no arguments")
+		}
+
+		return constructor(), nil
+	}
+}
+
+func withInteger(constructor func(integer int) rules.Rule) RuleFactory {
+	return func(arguments []string) (rules.Rule, error) {
+		if len(arguments) != 1 {
+			return nil, fmt.Errorf("the rule takes a single integer")
+		}
+
+		integer, err := strconv.Atoi(arguments[0])
+		if err != nil {
+			return nil, fmt.Errorf("the argument %q is not an integer", arguments[0])
+		}
+
+		return constructor(integer), nil
+	}
+}
+
+// withLimit passes integral limits as int and the others as json.Number, so that "max:0.5" is compared exactly.
+func withLimit(integerConstructor func(limit int) rules.Rule, numberConstructor func(limit json.Number) rules.Rule) RuleFactory {
+	return func(arguments []string) (rules.Rule, error) {
+		if len(arguments) != 1 {
+			return nil, fmt.Errorf("the rule takes a single number")
+		}
+
+		return numericRule(arguments[0], integerConstructor, numberConstructor)
+	}
+}
+
+func numericRule(argument string, integerConstructor func(limit int) rules.Rule, numberConstructor func(limit json.Number) rules.Rule) (rules.Rule, error) {
+	if integer, err := strconv.Atoi(argument); err == nil {
+		return integerConstructor(integer), nil
+	}
+
+	if err := checkNumber(argument); err != nil {
+		return nil, err
+	}
+
+	return numberConstructor(json.Number(argument)), nil
+}
+
+func checkNumber(argument string) error {
+	if _, ok := new(big.Rat).SetString(argument); !ok {
+		return fmt.Errorf("the limit %q is not a number", argument)
+	}
+
+	return nil
+}
+
+func multipleOf(arguments []string) (rules.Rule, error) {
+	if len(arguments) == 1 {
+		if step, ok := new(big.Rat).SetString(arguments[0]); ok && step.Sign() == 0 {
+			return nil, fmt.Errorf("the step must not be zero")
+		}
+	}
+
+	return withLimit(rules.MultipleOf, rules.MultipleOf)(arguments)
+}
+
+func between(arguments []string) (rules.Rule, error) {
+	if len(arguments) != 2 {
+		return nil, fmt.Errorf("the rule takes a minimum and a maximum")
+	}
+
+	min, minErr := strconv.Atoi(arguments[0])
+	max, maxErr := strconv.Atoi(arguments[1])
+	if minErr == nil && maxErr == nil {
+		return rules.Between(min, max), nil
+	}
+
+	for _, argument := range arguments {
+		if err := checkNumber(argument); err != nil {
+			return nil, err
+		}
+	}
+
+	return rules.Between(json.Number(arguments[0]), json.Number(arguments[1])), nil
+}
+
+func withString(constructor func(argument string) rules.Rule) RuleFactory {
+	return func(arguments []string) (rules.Rule, error) {
+		if len(arguments) != 1 || len(arguments[0]) == 0 {
+			return nil, fmt.Errorf("the rule takes a single argument")
+		}
+
+		return constructor(arguments[0]), nil
+	}
+}
+
+// withDate passes the reference and an optional IANA location, e.g. "before:today,Europe/Moscow".
+func withDate(constructor func(reference string, location *time.Location) rules.Rule) RuleFactory {
+	return func(arguments []string) (rules.Rule, error) {
+		if len(arguments) == 0 || len(arguments) > 2 || len(arguments[0]) == 0 {
+			return nil, fmt.Errorf("the rule takes a date and an optional location")
+		}
+
+		location := time.UTC
+		if len(arguments) == 2 {
+			loaded, err := time.LoadLocation(arguments[1])
+			if err != nil {
+				return nil, fmt.Errorf("loading the location: %w", err)
+			}
+
+			location = loaded
+		}
+
+		return constructor(arguments[0], location), nil
+	}
+}
+
+// withWholeArgument passes the argument with its commas, e.g. the pattern of "regex:^[a-z]{1,3}$".
+func withWholeArgument(constructor func(argument string) (rules.Rule, error)) RuleFactory {
+	return func(arguments []string) (rules.Rule, error) {
+		argument := strings.Join(arguments, ",")
+		if len(argument) == 0 {
+			return nil, fmt.Errorf("the rule requires an argument")
+		}
+
+		return constructor(argument)
+	}
+}
+
+func withStrings(constructor func(arguments ...string) rules.Rule) RuleFactory {
+	return func(arguments []string) (rules.Rule, error) {
+		if len(arguments) == 0 {
+			return nil, fmt.Errorf("the rule requires at least one argument")
+		}
+
+		return constructor(arguments...), nil
+	}
+}
+
+func withOptionalStrings(constructor func(arguments ...string) rules.Rule) RuleFactory {
+	return func(arguments []string) (rules.Rule, error) {
+		return constructor(arguments...), nil
+	}
+}
+
+func withValues(constructor func(values ...any) rules.Rule) RuleFactory {
+	return func(arguments []string) (rules.Rule, error) {
+		if len(arguments) == 0 {
+			return nil, fmt.Errorf("the rule requires at least one value")
+		}
+
+		return constructor(toValues(arguments)...), nil
+	}
+}
+
+func withFieldAndValues(constructor func(other string, values ...any) rules.Rule) RuleFactory {
+	return func(arguments []string) (rules.Rule, error) {
+		if len(arguments) < 2 {
+			return nil, fmt.Errorf("the rule requires a field and at least one value")
+		}
+
+		return constructor(arguments[0], toValues(arguments[1:])...), nil
+	}
+}
+
+func regex(pattern string) (rules.Rule, error) {
+	compiled, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("compiling the pattern: %w", err)
+	}
+
+	return rules.CompiledRegex(compiled), nil
+}
+
+func uuid(arguments []string) (rules.Rule, error) {
+	if len(arguments) == 0 {
+		return rules.UUID(0), nil
+	}
+
+	version, err := strconv.Atoi(arguments[0])
+	if len(arguments) > 1 || err != nil {
+		return nil, fmt.Errorf("the rule takes a single version number")
+	}
+
+	return rules.UUID(version), nil
+}
+
+func toValues(arguments []string) []any {
+	values := make([]any, len(arguments))
+	for i, argument := range arguments {
+		values[i] = argument
+	}
+
+	return values
+}
+
+func withScripts(constructor func(scripts ...*unicode.RangeTable) rules.Rule) RuleFactory {
+	return func(arguments []string) (rules.Rule, error) {
+		scripts := make([]*unicode.RangeTable, len(arguments))
+		for i, name := range arguments {
+			script, exists := unicode.Scripts[name]
+			if !exists {
+				return nil, fmt.Errorf("unknown script %q", name)
+			}
+
+			scripts[i] = script
+		}
+
+		return constructor(scripts...), nil
+	}
+}
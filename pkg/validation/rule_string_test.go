@@ -0,0 +1,121 @@
+package validation
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/EugeneNail/motivatr-lib-common/pkg/validation/messages"
+	"github.com/EugeneNail/motivatr-lib-common/pkg/validation/rules"
+)
+
+func TestParseRules(t *testing.T) {
+	tableTests := []struct {
+		name string
+		spec string
+		data map[string]any
+		want string
+	}{
+		{"Valid value", "required|max:255|regex:^[a-z]+$|in:daily,weekly", map[string]any{"test": "daily"}, ""},
+		{"Missing value", "required|max:255|regex:^[a-z]+$|in:daily,weekly", map[string]any{}, "The test field is required"},
+		{"Value failing the regex", "required|regex:^[a-z]+$|in:daily,weekly", map[string]any{"test": "Daily"}, "The test field format is invalid"},
+		{"Unlisted value", "required|regex:^[a-z]+$|in:daily,weekly", map[string]any{"test": "hourly"}, "The selected test is invalid"},
+		{"Regex with a group of alternatives", "regex:^(daily|weekly)$|max:5", map[string]any{"test": "weekly"}, "The test field must not be greater than 5 characters"},
+		{"Regex with an escaped pipe", `regex:^a\|b$`, map[string]any{"test": "a|b"}, ""},
+		{"Regex with a parenthesis and a pipe in brackets", "regex:^[(|]$|max:1", map[string]any{"test": "|"}, ""},
+		{"Regex with commas", "regex:^[a-z]{1,3}$", map[string]any{"test": "abcd"}, "The test field format is invalid"},
+		{"Listed number", "in:1,2,3", map[string]any{"test": int64(2)}, ""},
+		{"Listed numeric string", "in:1,2,3", map[string]any{"test": "2"}, ""},
+		{"Listed string with leading zeros", "in:01,02", map[string]any{"test": "01"}, ""},
+		{"Number with leading zeros", "in:007", map[string]any{"test": 7.0}, ""},
+		{"String with leading zeros", "in:007", map[string]any{"test": "007"}, ""},
+		{"Unlisted string with leading zeros", "in:007", map[string]any{"test": "7"}, "The selected test is invalid"},
+		{"Listed boolean", "in:true", map[string]any{"test": true}, ""},
+		{"Unicode alphabet of a script", "unicode_alpha:Cyrillic", map[string]any{"test": "abc"}, "The test field must only contain letters"},
+		{"Spaces around entries", " nullable | min:3 ", map[string]any{"test": nil}, ""},
+		{"Fractional limit", "numeric|max:0.5", map[string]any{"test": 0.75}, "The test field must not be greater than 0.5"},
+		{"Price", "decimal:2|positive", map[string]any{"test": 19.999}, "The test field must be a number with at most 2 decimal places"},
+		{"Between", "between:3,5", map[string]any{"test": "ab"}, "The test field must be between 3 and 5 characters"},
+		{"Required if", "required_if:frequency,weekly", map[string]any{"frequency": "weekly"}, "The test field is required when frequency is weekly"},
+		{"Date after a field", "date|after:start", map[string]any{"test": "2025-01-01", "start": "2025-02-01"}, "The test field must be a date after start"},
+		{"Date after a date in a location", "after:2025-01-01T00:00:00Z,Europe/Moscow", map[string]any{"test": "2025-01-01 02:00:00"}, "The test field must be a date after 2025-01-01T00:00:00Z"},
+		{"UUID of a version", "uuid:4", map[string]any{"test": "9b2c6f3e-1d4a-1e7b-8c2d-3f4a5b6c7d8e"}, "The test field must be a valid UUID"},
+	}
+
+	for _, tt := range tableTests {
+		t.Run(tt.name, func(t *testing.T) {
+			ruleFuncs, err := ParseRules(tt.spec)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			validator := NewValidator(tt.data, map[string][]rules.Rule{"test": ruleFuncs})
+			if err := validator.Validate(); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got := validator.Errors()["test"]; got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseRulesRejectsInvalidSpecs(t *testing.T) {
+	tableTests := []struct {
+		name string
+		spec string
+	}{
+		{"Unknown rule", "required|unknown"},
+		{"Non-numeric limit", "max:ten"},
+		{"Missing limit", "min"},
+		{"Zero step", "multiple_of:0.0"},
+		{"Unexpected argument", "required:yes"},
+		{"Invalid regex", "regex:[a-z"},
+		{"Missing values", "required_if:frequency"},
+		{"Unknown script", "unicode_alpha:Klingon"},
+		{"Unknown location", "before:today,Mars/Olympus"},
+		{"Unclosed bracket", "in:[a,b|required"},
+		{"Unclosed parenthesis", "regex:^(a|b$|required"},
+		{"Unmatched parenthesis", "regex:^a)$|required"},
+		{"Unmatched bracket", "regex:^a]$|required"},
+	}
+
+	for _, tt := range tableTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseRules(tt.spec); err == nil {
+				t.Errorf("expected an error")
+			}
+		})
+	}
+}
+
+func TestRegisterRule(t *testing.T) {
+	RegisterRule("divisible_by", func(arguments []string) (rules.Rule, error) {
+		var divisor int64
+		if _, err := fmt.Sscan(arguments[0], &divisor); err != nil {
+			return nil, err
+		}
+
+		return rules.RuleFunc(func(data map[string]any, field string) (*messages.Message, error) {
+			if value, ok := data[field].(int64); ok && value%divisor != 0 {
+				return &messages.Message{Text: "The :field field must be divisible by :divisor", Params: map[string]any{"divisor": divisor}}, nil
+			}
+
+			return nil, nil
+		}), nil
+	})
+
+	ruleFuncs, err := ParseRuleSet(map[string]string{"count": "required|divisible_by:3"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	validator := NewValidator(map[string]any{"count": int64(7)}, ruleFuncs)
+	if err := validator.Validate(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got, want := validator.Errors()["count"], "The count field must be divisible by 3"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,107 @@
+package rules
+
+import (
+	"github.com/EugeneNail/motivatr-lib-common/pkg/validation/messages"
+	"golang.org/x/text/unicode/norm"
+	"sort"
+	"unicode"
+)
+
+// The classes of characters accepted by the unicode alphabet rules.
+const (
+	letters = 1 << iota
+	numbers
+	spaces
+	dashes
+)
+
+// UnicodeAlpha is the unicode aware counterpart of Alpha. It accepts letters with their combining marks,
+// e.g. "Сергей" or "José". The scripts restrict the letters, e.g. UnicodeAlpha(unicode.Cyrillic, unicode.Latin).
+func UnicodeAlpha(scripts ...*unicode.RangeTable) Rule {
+	return alphabetRule("alpha", letters, scripts)
+}
+
+// UnicodeAlphaNum is the unicode aware counterpart of AlphaNum. It accepts letters, marks and decimal digits.
+func UnicodeAlphaNum(scripts ...*unicode.RangeTable) Rule {
+	return alphabetRule("alpha_num", letters|numbers, scripts)
+}
+
+// UnicodeSan is the unicode aware counterpart of San. It accepts letters, marks, decimal digits and spaces.
+func UnicodeSan(scripts ...*unicode.RangeTable) Rule {
+	return alphabetRule("san", letters|numbers|spaces, scripts)
+}
+
+// UnicodeSand is the unicode aware counterpart of Sand. It accepts letters, marks, decimal digits, spaces and dashes.
+func UnicodeSand(scripts ...*unicode.RangeTable) Rule {
+	return alphabetRule("sand", letters|numbers|spaces|dashes, scripts)
+}
+
+// alphabetRule checks every character of the NFC normalized string against the classes, so that the letters
+// composed of a base and a combining mark pass the script check. Combining marks that are left pass only
+// after an allowed letter, so a string of bare accents fails. Absent and null fields and empty strings pass,
+// like in CompiledRegex, while values of other types fail.
+func alphabetRule(key string, classes int, scripts []*unicode.RangeTable) Rule {
+	params := map[string]any{"scripts": scriptNames(scripts)}
+
+	return described(Descriptor{Name: key, Params: params}, func(data map[string]any, field string) (*messages.Message, error) {
+		value, exists := data[field]
+		if !exists || value == nil {
+			return nil, nil
+		}
+
+		text, ok := value.(string)
+		if !ok {
+			return messages.New(key, field, nil), nil
+		}
+
+		afterLetter := false
+		for _, character := range norm.NFC.String(text) {
+			if unicode.IsMark(character) {
+				if !afterLetter {
+					return messages.New(key, field, nil), nil
+				}
+
+				continue
+			}
+
+			if !isAllowedCharacter(character, classes, scripts) {
+				return messages.New(key, field, nil), nil
+			}
+
+			afterLetter = unicode.IsLetter(character)
+		}
+
+		return nil, nil
+	})
+}
+
+func isAllowedCharacter(character rune, classes int, scripts []*unicode.RangeTable) bool {
+	switch {
+	case unicode.IsLetter(character):
+		return classes&letters != 0 && (len(scripts) == 0 || unicode.IsOneOf(scripts, character))
+	case unicode.Is(unicode.Nd, character):
+		return classes&numbers != 0
+	case unicode.IsSpace(character):
+		return classes&spaces != 0
+	case unicode.Is(unicode.Pd, character):
+		return classes&dashes != 0
+	}
+
+	return false
+}
+
+// scriptNames returns the sorted names of the scripts as they appear in unicode.Scripts.
+func scriptNames(scripts []*unicode.RangeTable) []string {
+	names := make([]string, 0, len(scripts))
+	for name, table := range unicode.Scripts {
+		for _, script := range scripts {
+			if script == table {
+				names = append(names, name)
+			}
+		}
+	}
+
+	sort.Strings(names)
+
+	return names
+}
@@ -0,0 +1,44 @@
+package rules
+
+import (
+	"testing"
+	"unicode"
+)
+
+func TestUnicodeAlphabet(t *testing.T) {
+	tableTests := []struct {
+		name     string
+		ruleFunc Rule
+		data     map[string]any
+		want     string
+	}{
+		{"Missing field", UnicodeAlpha(), map[string]any{}, noError},
+		{"Empty string", UnicodeAlpha(), map[string]any{"test": ""}, noError},
+		{"Null value", UnicodeAlpha(), map[string]any{"test": nil}, noError},
+		{"Cyrillic letters", UnicodeAlpha(), map[string]any{"test": "Сергей"}, noError},
+		{"Latin letters with diacritics", UnicodeAlpha(), map[string]any{"test": "José"}, noError},
+		{"Letter of a base and a combining mark", UnicodeAlpha(unicode.Cyrillic), map[string]any{"test": "Андре\u0438\u0306"}, noError},
+		{"Letter with combining marks without a composed form", UnicodeAlpha(unicode.Latin), map[string]any{"test": "q\u0307\u0323"}, noError},
+		{"Combining marks only", UnicodeAlpha(), map[string]any{"test": "\u0301\u0301"}, "The test field must only contain letters"},
+		{"Combining mark after a space", UnicodeSan(), map[string]any{"test": "Anna \u0301"}, "The test field must only contain letters, numbers and spaces"},
+		{"Combining mark after a number", UnicodeAlphaNum(), map[string]any{"test": "2\u0301"}, "The test field must only contain letters and numbers"},
+		{"Letters with a number", UnicodeAlpha(), map[string]any{"test": "Сергей2"}, "The test field must only contain letters"},
+		{"Letters of an allowed script", UnicodeAlpha(unicode.Cyrillic, unicode.Latin), map[string]any{"test": "Anna"}, noError},
+		{"Letters of another script", UnicodeAlpha(unicode.Cyrillic), map[string]any{"test": "Anna"}, "The test field must only contain letters"},
+		{"Non-string value", UnicodeAlpha(), map[string]any{"test": 42}, "The test field must only contain letters"},
+		{"Letters and Arabic-Indic digits", UnicodeAlphaNum(), map[string]any{"test": "Сергей٣"}, noError},
+		{"Letters and numbers with a space", UnicodeAlphaNum(), map[string]any{"test": "Сергей 2"}, "The test field must only contain letters and numbers"},
+		{"Letters, numbers and spaces", UnicodeSan(), map[string]any{"test": "Улица Ленина 5"}, noError},
+		{"Letters with a dash", UnicodeSan(), map[string]any{"test": "Римский-Корсаков"}, "The test field must only contain letters, numbers and spaces"},
+		{"Letters with a dash and an en dash", UnicodeSand(), map[string]any{"test": "Римский-Корсаков – композитор"}, noError},
+		{"Letters with punctuation", UnicodeSand(), map[string]any{"test": "O'Brien"}, "The test field must only contain letters, numbers, spaces and dashes"},
+	}
+
+	for _, tt := range tableTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, _ := tt.ruleFunc.Check(tt.data, "test"); got.String() != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
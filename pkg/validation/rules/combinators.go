@@ -0,0 +1,80 @@
+package rules
+
+import (
+	"github.com/EugeneNail/motivatr-lib-common/pkg/validation/messages"
+)
+
+// AllOf passes when every rule passes and returns the failure of the first rule that does not.
+func AllOf(ruleFuncs ...Rule) Rule {
+	return describedAll(describeAll(ruleFuncs), func(data map[string]any, field string) (*messages.Message, error) {
+		for _, ruleFunc := range ruleFuncs {
+			message, err := ruleFunc.Check(data, field)
+			if err != nil || message != nil {
+				return message, err
+			}
+		}
+
+		return nil, nil
+	})
+}
+
+// AnyOf passes when at least one of the rules passes, e.g. AnyOf(Regex(Email), Regex(phonePattern)).
+// When all of them fail, the failure of the first rule is returned.
+func AnyOf(ruleFuncs ...Rule) Rule {
+	descriptor := Descriptor{Name: "any_of"}
+	for _, ruleFunc := range ruleFuncs {
+		descriptor.Children = append(descriptor.Children, Descriptor{Name: "all_of", Children: Describe(ruleFunc)})
+	}
+
+	return described(descriptor, func(data map[string]any, field string) (*messages.Message, error) {
+		var first *messages.Message
+
+		for _, ruleFunc := range ruleFuncs {
+			message, err := ruleFunc.Check(data, field)
+			if err != nil {
+				return nil, err
+			}
+
+			if message == nil {
+				return nil, nil
+			}
+
+			if first == nil {
+				first = message
+			}
+		}
+
+		return first, nil
+	})
+}
+
+// Not passes when the rule fails and fails when the rule passes.
+func Not(ruleFunc Rule) Rule {
+	descriptor := Descriptor{Name: "not", Children: Describe(ruleFunc)}
+
+	return described(descriptor, func(data map[string]any, field string) (*messages.Message, error) {
+		message, err := ruleFunc.Check(data, field)
+		if err != nil {
+			return nil, err
+		}
+
+		if message == nil {
+			return messages.New("not", field, nil), nil
+		}
+
+		return nil, nil
+	})
+}
+
+// WithMessage replaces the message of the rule with the text, keeping its key and parameters,
+// so the text may refer to them as placeholders, e.g. "The :field must have at most :limit characters".
+func WithMessage(ruleFunc Rule, text string) Rule {
+	return describedAll(Describe(ruleFunc), func(data map[string]any, field string) (*messages.Message, error) {
+		message, err := ruleFunc.Check(data, field)
+		if err != nil || message == nil {
+			return message, err
+		}
+
+		return &messages.Message{Key: message.Key, Field: message.Field, Params: message.Params, Text: text}, nil
+	})
+}
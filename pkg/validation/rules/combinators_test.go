@@ -0,0 +1,102 @@
+package rules
+
+import (
+	"reflect"
+	"testing"
+)
+
+const phone = `^\+[0-9]{11}$`
+
+func TestAllOf(t *testing.T) {
+	ruleFunc := AllOf(Required(), Max(5))
+	tableTests := []struct {
+		name string
+		data map[string]any
+		want string
+	}{
+		{"All rules pass", map[string]any{"test": "books"}, noError},
+		{"First rule fails", map[string]any{"test": ""}, "The test field is required"},
+		{"Second rule fails", map[string]any{"test": "reading"}, "The test field must not be greater than 5 characters"},
+	}
+
+	for _, tt := range tableTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, _ := ruleFunc.Check(tt.data, "test"); got.String() != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAnyOf(t *testing.T) {
+	ruleFunc := AnyOf(Regex(Email), Regex(phone))
+	tableTests := []struct {
+		name string
+		data map[string]any
+		want string
+	}{
+		{"Email", map[string]any{"test": "merlin@camelot.uk"}, noError},
+		{"Phone", map[string]any{"test": "+79990000000"}, noError},
+		{"Neither", map[string]any{"test": "merlin"}, "The test field format is invalid"},
+	}
+
+	for _, tt := range tableTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, _ := ruleFunc.Check(tt.data, "test"); got.String() != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNot(t *testing.T) {
+	ruleFunc := Not(In("admin", "root"))
+	tableTests := []struct {
+		name string
+		data map[string]any
+		want string
+	}{
+		{"Inner rule passes", map[string]any{"test": "admin"}, "The test field is invalid"},
+		{"Inner rule fails", map[string]any{"test": "merlin"}, noError},
+	}
+
+	for _, tt := range tableTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, _ := ruleFunc.Check(tt.data, "test"); got.String() != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithMessage(t *testing.T) {
+	ruleFunc := WithMessage(Max(5), "The :field must be at most :limit letters long")
+
+	got, _ := ruleFunc.Check(map[string]any{"test": "reading"}, "test")
+	if want := "The test must be at most 5 letters long"; got.String() != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	if got.Key != "max.string" {
+		t.Errorf("got key %q, want the key of the wrapped rule", got.Key)
+	}
+
+	if got, _ := ruleFunc.Check(map[string]any{"test": "book"}, "test"); got != nil {
+		t.Errorf("got %q, want no message", got)
+	}
+}
+
+func TestDescribeCombinators(t *testing.T) {
+	got := Describe(AllOf(Required(), AnyOf(Regex(Email), Not(Max(3)))))
+	want := []Descriptor{
+		{Name: "required"},
+		{Name: "any_of", Children: []Descriptor{
+			{Name: "all_of", Children: []Descriptor{{Name: "regex", Params: map[string]any{"pattern": Email}}}},
+			{Name: "all_of", Children: []Descriptor{{Name: "not", Children: []Descriptor{{Name: "max", Params: map[string]any{"limit": 3}}}}}},
+		}},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,252 @@
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"github.com/EugeneNail/motivatr-lib-common/pkg/validation/messages"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Between checks that a number, the length of a string or the size of an array or a map is within the limits inclusively.
+// The limits may be any Number, like the ones of Max and Min.
+func Between[N Number](min N, max N) Rule {
+	minBound, maxBound := newBound(min), newBound(max)
+	params := map[string]any{"min": displayNumber(min), "max": displayNumber(max)}
+
+	return described(Descriptor{Name: "between", Params: map[string]any{"min": min, "max": max}}, func(data map[string]any, field string) (*messages.Message, error) {
+		value, exists := data[field]
+		if !exists || value == nil {
+			return nil, nil
+		}
+
+		minComparison, kind, ok := measure(value, minBound)
+		if !ok {
+			return nil, nil
+		}
+
+		maxComparison, _, _ := measure(value, maxBound)
+		if minComparison < 0 || maxComparison > 0 {
+			return messages.New("between."+kind, field, params), nil
+		}
+
+		return nil, nil
+	})
+}
+
+// In checks that the field equals one of the values. Every item of an array must equal one of the values.
+// Numbers of different types are equal when they hold the same value, and a string value also matches a number,
+// a boolean or null with the same text, e.g. In("1") accepts both "1" and 1.
+func In(values ...any) Rule {
+	params := map[string]any{"values": joinValues(values)}
+
+	return described(Descriptor{Name: "in", Params: map[string]any{"values": values}}, func(data map[string]any, field string) (*messages.Message, error) {
+		value, exists := data[field]
+		if !exists {
+			return nil, nil
+		}
+
+		for _, item := range items(value) {
+			if !containsValue(values, item) {
+				return messages.New("in", field, params), nil
+			}
+		}
+
+		return nil, nil
+	})
+}
+
+// NotIn checks that the field equals none of the values. No item of an array may equal one of the values.
+func NotIn(values ...any) Rule {
+	params := map[string]any{"values": joinValues(values)}
+
+	return described(Descriptor{Name: "not_in", Params: map[string]any{"values": values}}, func(data map[string]any, field string) (*messages.Message, error) {
+		value, exists := data[field]
+		if !exists {
+			return nil, nil
+		}
+
+		for _, item := range items(value) {
+			if containsValue(values, item) {
+				return messages.New("not_in", field, params), nil
+			}
+		}
+
+		return nil, nil
+	})
+}
+
+// GreaterThanField compares the field with another one. Numbers are compared by value,
+// dates and times (time.Time or strings in the 2006-01-02 or RFC 3339 formats) chronologically,
+// other strings by length and arrays and maps by size. The rule passes when either field is absent.
+// Wildcards of the other field stand for the indices of the validated one, like in Same.
+func GreaterThanField(other string) Rule {
+	return compareWithField(other, "gt", func(comparison int) bool { return comparison > 0 })
+}
+
+func GreaterThanOrEqualField(other string) Rule {
+	return compareWithField(other, "gte", func(comparison int) bool { return comparison >= 0 })
+}
+
+func LessThanField(other string) Rule {
+	return compareWithField(other, "lt", func(comparison int) bool { return comparison < 0 })
+}
+
+func LessThanOrEqualField(other string) Rule {
+	return compareWithField(other, "lte", func(comparison int) bool { return comparison <= 0 })
+}
+
+func compareWithField(other string, key string, passes func(comparison int) bool) Rule {
+	return described(Descriptor{Name: key, Params: map[string]any{"other": other}}, func(data map[string]any, field string) (*messages.Message, error) {
+		other := resolvePath(other, field)
+		params := map[string]any{"other": messages.Field(other)}
+
+		value, exists := data[field]
+		otherValue, otherExists := data[other]
+		if !exists || !otherExists || value == nil || otherValue == nil {
+			return nil, nil
+		}
+
+		comparison, kind, ok := compareValues(value, otherValue)
+		if !ok {
+			return messages.New("comparable", field, params), nil
+		}
+
+		if !passes(comparison) {
+			return messages.New(key+"."+kind, field, params), nil
+		}
+
+		return nil, nil
+	})
+}
+
+// compareValues returns -1, 0 or 1 and the kind of the compared values: numeric, date, string or array.
+func compareValues(a any, b any) (comparison int, kind string, ok bool) {
+	if aTime, aIsTime := toTime(a); aIsTime {
+		bTime, bIsTime := toTime(b)
+		if !bIsTime {
+			return 0, "", false
+		}
+
+		return aTime.Compare(bTime), "date", true
+	}
+
+	aNumber, aIsNumber := toRat(a)
+	bNumber, bIsNumber := toRat(b)
+	if aIsNumber || bIsNumber {
+		if !aIsNumber || !bIsNumber {
+			return 0, "", false
+		}
+
+		return aNumber.Cmp(bNumber), "numeric", true
+	}
+
+	aLength, aKind, aOk := length(a)
+	bLength, bKind, bOk := length(b)
+	if !aOk || !bOk || aKind != bKind {
+		return 0, "", false
+	}
+
+	return compareInt64(int64(aLength), int64(bLength)), aKind, true
+}
+
+// length measures strings by the number of characters and arrays and maps by the number of items.
+func length(value any) (measured int, kind string, ok bool) {
+	if text, isString := value.(string); isString {
+		return utf8.RuneCountInString(text), "string", true
+	}
+
+	reflected := reflect.ValueOf(value)
+	switch reflected.Kind() {
+	case reflect.Slice, reflect.Map, reflect.Array:
+		return reflected.Len(), "array", true
+	}
+
+	return 0, "", false
+}
+
+// items returns the elements of a slice or an array, or the value itself otherwise.
+func items(value any) []any {
+	reflected := reflect.ValueOf(value)
+	if reflected.Kind() != reflect.Slice && reflected.Kind() != reflect.Array {
+		return []any{value}
+	}
+
+	result := make([]any, reflected.Len())
+	for i := range result {
+		result[i] = reflected.Index(i).Interface()
+	}
+
+	return result
+}
+
+func containsValue(values []any, value any) bool {
+	for _, candidate := range values {
+		if equalValues(candidate, value) || matchesText(candidate, value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchesText compares a string candidate with the text of a number, a boolean or null, so that the values of rule
+// strings like "in:1,2" or "in:true" match the values decoded from json. Numbers are compared by value,
+// so "01" matches 1 and "0.50" matches 0.5.
+func matchesText(candidate any, value any) bool {
+	text, isString := candidate.(string)
+	if !isString {
+		return false
+	}
+
+	if value == nil {
+		return text == "null"
+	}
+
+	if boolean, isBool := value.(bool); isBool {
+		return text == strconv.FormatBool(boolean)
+	}
+
+	number, isNumber := toRat(value)
+	if !isNumber {
+		return false
+	}
+
+	if _, err := strconv.ParseFloat(text, 64); err != nil && !errors.Is(err, strconv.ErrRange) {
+		return false
+	}
+
+	parsed, ok := parseDecimal(text)
+	return ok && parsed.Cmp(number) == 0
+}
+
+// equalValues compares the values treating numbers of different types as equal when they hold the same value,
+// so that an int from the code matches a float64 decoded from json.
+func equalValues(a any, b any) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+
+	aNumber, aIsNumber := toRat(a)
+	bNumber, bIsNumber := toRat(b)
+	if aIsNumber && bIsNumber {
+		return aNumber.Cmp(bNumber) == 0
+	}
+
+	if reflect.TypeOf(a).Comparable() && reflect.TypeOf(b).Comparable() {
+		return a == b
+	}
+
+	return reflect.DeepEqual(a, b)
+}
+
+func joinValues(values []any) string {
+	formatted := make([]string, len(values))
+	for i, value := range values {
+		formatted[i] = fmt.Sprint(value)
+	}
+
+	return strings.Join(formatted, ", ")
+}
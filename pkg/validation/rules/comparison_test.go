@@ -0,0 +1,117 @@
+package rules
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBetween(t *testing.T) {
+	ruleFunc := Between(3, 5)
+	tableTests := []struct {
+		name string
+		data map[string]any
+		want string
+	}{
+		{"Missing field", map[string]any{}, noError},
+		{"Number below the range", map[string]any{"test": 2}, "The test field must be between 3 and 5"},
+		{"Number at the lower limit", map[string]any{"test": int8(3)}, noError},
+		{"Number at the upper limit", map[string]any{"test": uint64(5)}, noError},
+		{"Float above the range", map[string]any{"test": 5.5}, "The test field must be between 3 and 5"},
+		{"Cyrillic string within the range", map[string]any{"test": "Ада"}, noError},
+		{"String above the range", map[string]any{"test": "Sebastian"}, "The test field must be between 3 and 5 characters"},
+		{"Array below the range", map[string]any{"test": []string{"1"}}, "The test field must have between 3 and 5 items"},
+		{"Map within the range", map[string]any{"test": map[int]int{1: 1, 2: 2, 3: 3}}, noError},
+	}
+
+	for _, tt := range tableTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, _ := ruleFunc.Check(tt.data, "test"); got.String() != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIn(t *testing.T) {
+	tableTests := []struct {
+		name     string
+		ruleFunc Rule
+		data     map[string]any
+		want     string
+	}{
+		{"Missing field", In("daily", "weekly"), map[string]any{}, noError},
+		{"Listed string", In("daily", "weekly"), map[string]any{"test": "weekly"}, noError},
+		{"Unlisted string", In("daily", "weekly"), map[string]any{"test": "hourly"}, "The selected test is invalid"},
+		{"Listed number of another type", In(1, 2, 3), map[string]any{"test": float64(2)}, noError},
+		{"Unlisted number", In(1, 2, 3), map[string]any{"test": int64(4)}, "The selected test is invalid"},
+		{"Listed array items", In("daily", "weekly"), map[string]any{"test": []any{"daily", "weekly"}}, noError},
+		{"Unlisted array item", In("daily", "weekly"), map[string]any{"test": []string{"daily", "hourly"}}, "The selected test is invalid"},
+		{"Excluded string", NotIn("deleted"), map[string]any{"test": "deleted"}, "The selected test is invalid"},
+		{"Not excluded string", NotIn("deleted"), map[string]any{"test": "active"}, noError},
+		{"Excluded array item", NotIn(0), map[string]any{"test": []int{3, 0}}, "The selected test is invalid"},
+	}
+
+	for _, tt := range tableTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, _ := tt.ruleFunc.Check(tt.data, "test"); got.String() != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFieldComparisons(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	tableTests := []struct {
+		name     string
+		ruleFunc Rule
+		data     map[string]any
+		want     string
+	}{
+		{"Missing other field", GreaterThanField("start"), map[string]any{"test": 5}, noError},
+		{"Greater number", GreaterThanField("start"), map[string]any{"test": 5, "start": 4.5}, noError},
+		{"Equal number", GreaterThanField("start"), map[string]any{"test": uint8(5), "start": int64(5)}, "The test field must be greater than start"},
+		{"Equal number inclusive", GreaterThanOrEqualField("start"), map[string]any{"test": 5, "start": 5}, noError},
+		{"Less number", LessThanField("start"), map[string]any{"test": 3, "start": 5}, noError},
+		{"Greater number", LessThanOrEqualField("start"), map[string]any{"test": 6, "start": 5}, "The test field must be less than or equal to start"},
+		{"Later date", GreaterThanField("start"), map[string]any{"test": "2025-01-02", "start": "2025-01-01"}, noError},
+		{"Earlier date", GreaterThanField("start"), map[string]any{"test": "2024-12-31", "start": "2025-01-01"}, "The test field must be a date after start"},
+		{"Date against time", LessThanField("start"), map[string]any{"test": "2024-12-31T23:00:00Z", "start": start}, noError},
+		{"Offsets", LessThanField("start"), map[string]any{"test": "2025-01-01T02:00:00+03:00", "start": "2025-01-01T00:00:00Z"}, noError},
+		{"Longer string", GreaterThanField("start"), map[string]any{"test": "Сергей", "start": "Ян"}, noError},
+		{"Shorter string", GreaterThanOrEqualField("start"), map[string]any{"test": "Ян", "start": "Сергей"}, "The test field must not be shorter than start"},
+		{"Fewer items", GreaterThanField("start"), map[string]any{"test": []int{1}, "start": []int{1, 2}}, "The test field must have more items than start"},
+		{"Different types", GreaterThanField("start"), map[string]any{"test": "five", "start": 4}, "The test field cannot be compared with start"},
+	}
+
+	for _, tt := range tableTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, _ := tt.ruleFunc.Check(tt.data, "test"); got.String() != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFieldComparisonsOfSiblings(t *testing.T) {
+	data := map[string]any{"rows.0.a": "x", "rows.0.b": "x", "rows.1.a": "y", "rows.1.b": "z", "rows.0.min": 1, "rows.0.max": 5}
+	tableTests := []struct {
+		name     string
+		ruleFunc Rule
+		field    string
+		want     string
+	}{
+		{"Same sibling", Same("rows.*.a"), "rows.0.b", noError},
+		{"Different sibling", Same("rows.*.a"), "rows.1.b", "The rows.1.b field must match rows.1.a"},
+		{"Greater sibling", GreaterThanField("rows.*.min"), "rows.0.max", noError},
+		{"Less sibling", LessThanField("rows.*.min"), "rows.0.max", "The rows.0.max field must be less than rows.0.min"},
+	}
+
+	for _, tt := range tableTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, _ := tt.ruleFunc.Check(data, tt.field); got.String() != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
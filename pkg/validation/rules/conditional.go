@@ -0,0 +1,117 @@
+package rules
+
+import (
+	"errors"
+	"github.com/EugeneNail/motivatr-lib-common/pkg/validation/messages"
+	"strings"
+)
+
+// ErrSkip is returned by a rule to stop validating the field without an error.
+// The validator does not run the rules that follow it.
+var ErrSkip = errors.New("skip the remaining rules of the field")
+
+// Sometimes skips the remaining rules of the field when it is absent.
+func Sometimes() Rule {
+	return described(Descriptor{Name: "sometimes"}, func(data map[string]any, field string) (*messages.Message, error) {
+		if _, exists := data[field]; !exists {
+			return nil, ErrSkip
+		}
+
+		return nil, nil
+	})
+}
+
+// Nullable skips the remaining rules of the field when it is explicitly null.
+func Nullable() Rule {
+	return described(Descriptor{Name: "nullable"}, func(data map[string]any, field string) (*messages.Message, error) {
+		if value, exists := data[field]; exists && value == nil {
+			return nil, ErrSkip
+		}
+
+		return nil, nil
+	})
+}
+
+// RequiredIf makes the field required when the other field equals any of the values. Wildcards of the other field
+// stand for the indices of the validated one, e.g. RequiredIf("steps.*.enabled", true) of "steps.2.time"
+// looks at "steps.2.enabled".
+func RequiredIf(other string, values ...any) Rule {
+	return described(Descriptor{Name: "required_if", Params: map[string]any{"other": other, "values": values}}, func(data map[string]any, field string) (*messages.Message, error) {
+		other := resolvePath(other, field)
+		if !containsValue(values, data[other]) {
+			return nil, nil
+		}
+
+		params := map[string]any{"other": messages.Field(other), "values": joinValues(values)}
+		return requiredMessage(data, field, "required_if", params), nil
+	})
+}
+
+// RequiredUnless makes the field required unless the other field equals any of the values.
+func RequiredUnless(other string, values ...any) Rule {
+	return described(Descriptor{Name: "required_unless", Params: map[string]any{"other": other, "values": values}}, func(data map[string]any, field string) (*messages.Message, error) {
+		other := resolvePath(other, field)
+		if containsValue(values, data[other]) {
+			return nil, nil
+		}
+
+		params := map[string]any{"other": messages.Field(other), "values": joinValues(values)}
+		return requiredMessage(data, field, "required_unless", params), nil
+	})
+}
+
+// RequiredWith makes the field required when any of the other fields is present and not empty.
+func RequiredWith(others ...string) Rule {
+	params := map[string]any{"values": strings.Join(others, ", ")}
+
+	return described(Descriptor{Name: "required_with", Params: map[string]any{"others": others}}, func(data map[string]any, field string) (*messages.Message, error) {
+		for _, other := range others {
+			if value, exists := data[resolvePath(other, field)]; exists && !isEmpty(value) {
+				return requiredMessage(data, field, "required_with", params), nil
+			}
+		}
+
+		return nil, nil
+	})
+}
+
+// RequiredWithout makes the field required when any of the other fields is absent or empty.
+func RequiredWithout(others ...string) Rule {
+	params := map[string]any{"values": strings.Join(others, ", ")}
+
+	return described(Descriptor{Name: "required_without", Params: map[string]any{"others": others}}, func(data map[string]any, field string) (*messages.Message, error) {
+		for _, other := range others {
+			if value, exists := data[resolvePath(other, field)]; !exists || isEmpty(value) {
+				return requiredMessage(data, field, "required_without", params), nil
+			}
+		}
+
+		return nil, nil
+	})
+}
+
+func requiredMessage(data map[string]any, field string, key string, params map[string]any) *messages.Message {
+	if value, exists := data[field]; exists && !isEmpty(value) {
+		return nil
+	}
+
+	return messages.New(key, field, params)
+}
+
+// resolvePath replaces the wildcards of the path with the segments of the field at the same positions,
+// so that rules of "steps.*.time" can refer to the sibling "steps.*.enabled" of the same step.
+func resolvePath(path string, field string) string {
+	if !strings.Contains(path, "*") {
+		return path
+	}
+
+	pathParts := strings.Split(path, ".")
+	fieldParts := strings.Split(field, ".")
+	for i, part := range pathParts {
+		if part == "*" && i < len(fieldParts) {
+			pathParts[i] = fieldParts[i]
+		}
+	}
+
+	return strings.Join(pathParts, ".")
+}
@@ -0,0 +1,153 @@
+package rules
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestRequiredIf(t *testing.T) {
+	ruleFunc := RequiredIf("reminders_enabled", true)
+	tableTests := []struct {
+		name string
+		data map[string]any
+		want string
+	}{
+		{"Condition met, field missing", map[string]any{"reminders_enabled": true}, "The test field is required when reminders_enabled is true"},
+		{"Condition met, field empty", map[string]any{"reminders_enabled": true, "test": ""}, "The test field is required when reminders_enabled is true"},
+		{"Condition met, field present", map[string]any{"reminders_enabled": true, "test": "09:00"}, noError},
+		{"Condition not met", map[string]any{"reminders_enabled": false}, noError},
+		{"Other field missing", map[string]any{}, noError},
+	}
+
+	for _, tt := range tableTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, _ := ruleFunc.Check(tt.data, "test"); got.String() != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRequiredIfOfSiblings(t *testing.T) {
+	ruleFunc := RequiredIf("steps.*.enabled", true)
+	tableTests := []struct {
+		name  string
+		field string
+		data  map[string]any
+		want  string
+	}{
+		{"Enabled step without a time", "steps.0.time", map[string]any{"steps.0.enabled": true, "steps.1.enabled": false}, "The steps.0.time field is required when steps.0.enabled is true"},
+		{"Disabled step without a time", "steps.1.time", map[string]any{"steps.0.enabled": true, "steps.1.enabled": false}, noError},
+		{"Enabled step with a time", "steps.0.time", map[string]any{"steps.0.enabled": true, "steps.0.time": "09:00"}, noError},
+	}
+
+	for _, tt := range tableTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, _ := ruleFunc.Check(tt.data, tt.field); got.String() != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRequiredIfComparesNumbersByValue(t *testing.T) {
+	ruleFunc := RequiredIf("frequency", 1, 2)
+	if got, _ := ruleFunc.Check(map[string]any{"frequency": float64(2)}, "test"); got == nil {
+		t.Errorf("expected the field to be required")
+	}
+}
+
+func TestRequiredUnless(t *testing.T) {
+	ruleFunc := RequiredUnless("status", "draft", "archived")
+	tableTests := []struct {
+		name string
+		data map[string]any
+		want string
+	}{
+		{"Condition met", map[string]any{"status": "draft"}, noError},
+		{"Condition not met, field missing", map[string]any{"status": "active"}, "The test field is required unless status is in draft, archived"},
+		{"Condition not met, field present", map[string]any{"status": "active", "test": 5}, noError},
+		{"Other field missing", map[string]any{}, "The test field is required unless status is in draft, archived"},
+	}
+
+	for _, tt := range tableTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, _ := ruleFunc.Check(tt.data, "test"); got.String() != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRequiredWith(t *testing.T) {
+	ruleFunc := RequiredWith("email", "phone")
+	tableTests := []struct {
+		name string
+		data map[string]any
+		want string
+	}{
+		{"No other fields", map[string]any{}, noError},
+		{"Empty other field", map[string]any{"email": ""}, noError},
+		{"Other field present, field missing", map[string]any{"phone": "+79990000000"}, "The test field is required when email, phone is present"},
+		{"Other field present, field present", map[string]any{"email": "merlin@camelot.uk", "test": "Merlin"}, noError},
+	}
+
+	for _, tt := range tableTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, _ := ruleFunc.Check(tt.data, "test"); got.String() != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRequiredWithout(t *testing.T) {
+	ruleFunc := RequiredWithout("email")
+	tableTests := []struct {
+		name string
+		data map[string]any
+		want string
+	}{
+		{"Other field missing, field missing", map[string]any{}, "The test field is required when email is not present"},
+		{"Other field empty, field missing", map[string]any{"email": ""}, "The test field is required when email is not present"},
+		{"Other field missing, field present", map[string]any{"test": "+79990000000"}, noError},
+		{"Other field present", map[string]any{"email": "merlin@camelot.uk"}, noError},
+	}
+
+	for _, tt := range tableTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, _ := ruleFunc.Check(tt.data, "test"); got.String() != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSometimesAndNullable(t *testing.T) {
+	tableTests := []struct {
+		name     string
+		ruleFunc Rule
+		data     map[string]any
+		wantSkip bool
+	}{
+		{"Sometimes with missing field", Sometimes(), map[string]any{}, true},
+		{"Sometimes with null field", Sometimes(), map[string]any{"test": nil}, false},
+		{"Sometimes with present field", Sometimes(), map[string]any{"test": "Merlin"}, false},
+		{"Nullable with missing field", Nullable(), map[string]any{}, false},
+		{"Nullable with null field", Nullable(), map[string]any{"test": nil}, true},
+		{"Nullable with present field", Nullable(), map[string]any{"test": "Merlin"}, false},
+	}
+
+	for _, tt := range tableTests {
+		t.Run(tt.name, func(t *testing.T) {
+			message, err := tt.ruleFunc.Check(tt.data, "test")
+			if message != nil {
+				t.Errorf("got %q, want no message", message)
+			}
+
+			if got := errors.Is(err, ErrSkip); got != tt.wantSkip {
+				t.Errorf("got skip %v, want %v", got, tt.wantSkip)
+			}
+		})
+	}
+}
@@ -0,0 +1,172 @@
+package rules
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"github.com/EugeneNail/motivatr-lib-common/pkg/validation/messages"
+	"github.com/lib/pq"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// ContextRuleFunc is a rule that needs a context, e.g. to query a database.
+// The validator runs context rules of a field only after its regular rules have passed.
+type ContextRuleFunc func(ctx context.Context, data map[string]any, field string) (message *messages.Message, err error)
+
+// Querier runs the queries of the database rules. It is satisfied by *sql.DB, *sql.Tx and *sql.Conn,
+// e.g. by the connection returned from databases.ConnectToPostgres.
+type Querier interface {
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+type condition struct {
+	column   string
+	operator string
+	value    any
+}
+
+// QueryOption narrows down the rows checked by Unique and Exists.
+type QueryOption func(conditions []condition) []condition
+
+// Where only checks the rows where the column equals the value.
+func Where(column string, value any) QueryOption {
+	return func(conditions []condition) []condition {
+		return append(conditions, condition{column, "=", value})
+	}
+}
+
+// WhereNull only checks the rows where the column is null, e.g. the rows that are not soft deleted.
+func WhereNull(column string) QueryOption {
+	return func(conditions []condition) []condition {
+		return append(conditions, condition{column, "IS NULL", nil})
+	}
+}
+
+// Ignore skips the rows where the column equals the value, e.g. the record being updated.
+func Ignore(column string, value any) QueryOption {
+	return func(conditions []condition) []condition {
+		return append(conditions, condition{column, "<>", value})
+	}
+}
+
+// Unique checks that no row of the Postgres table has the value of the field in the column.
+// Values other than strings, numbers, booleans and times fail with the scalar message instead of reaching the database.
+// Values the column cannot hold, e.g. a string of an integer column, are database errors, so declare a type rule
+// like Integer among the regular rules of the field, which run first.
+func Unique(db Querier, table string, column string, options ...QueryOption) ContextRuleFunc {
+	query, args := existenceQuery(table, column, options)
+
+	return func(ctx context.Context, data map[string]any, field string) (*messages.Message, error) {
+		value, present := data[field]
+		if !present || value == nil {
+			return nil, nil
+		}
+
+		if !isScalar(value) {
+			return messages.New("scalar", field, nil), nil
+		}
+
+		exists, err := queryExistence(ctx, db, value, query, args)
+		if err != nil {
+			return nil, err
+		}
+
+		if exists {
+			return messages.New("unique", field, nil), nil
+		}
+
+		return nil, nil
+	}
+}
+
+// Exists checks that a row of the Postgres table has the value of the field in the column.
+// Values are checked like in Unique.
+func Exists(db Querier, table string, column string, options ...QueryOption) ContextRuleFunc {
+	query, args := existenceQuery(table, column, options)
+
+	return func(ctx context.Context, data map[string]any, field string) (*messages.Message, error) {
+		value, present := data[field]
+		if !present || value == nil {
+			return nil, nil
+		}
+
+		if !isScalar(value) {
+			return messages.New("scalar", field, nil), nil
+		}
+
+		exists, err := queryExistence(ctx, db, value, query, args)
+		if err != nil {
+			return nil, err
+		}
+
+		if !exists {
+			return messages.New("exists", field, nil), nil
+		}
+
+		return nil, nil
+	}
+}
+
+// isScalar tells whether the value can be a query argument of a column.
+func isScalar(value any) bool {
+	switch value.(type) {
+	case string, bool, json.Number, time.Time:
+		return true
+	}
+
+	switch reflect.ValueOf(value).Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Float32, reflect.Float64:
+		return true
+	}
+
+	return false
+}
+
+func queryExistence(ctx context.Context, db Querier, value any, query string, args []any) (bool, error) {
+	var exists bool
+	if err := db.QueryRowContext(ctx, query, append([]any{value}, args...)...).Scan(&exists); err != nil {
+		return false, fmt.Errorf("querying the database: %w", err)
+	}
+
+	return exists, nil
+}
+
+// existenceQuery builds the query with the value of the field as the first argument followed by the condition values.
+func existenceQuery(table string, column string, options []QueryOption) (string, []any) {
+	var conditions []condition
+	for _, option := range options {
+		conditions = option(conditions)
+	}
+
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "SELECT EXISTS (SELECT 1 FROM %s WHERE %s = $1", quoteTable(table), pq.QuoteIdentifier(column))
+
+	var args []any
+	for _, condition := range conditions {
+		if condition.operator == "IS NULL" {
+			fmt.Fprintf(&builder, " AND %s IS NULL", pq.QuoteIdentifier(condition.column))
+			continue
+		}
+
+		args = append(args, condition.value)
+		fmt.Fprintf(&builder, " AND %s %s $%d", pq.QuoteIdentifier(condition.column), condition.operator, len(args)+1)
+	}
+
+	builder.WriteString(")")
+
+	return builder.String(), args
+}
+
+// quoteTable quotes every part of a table name that may be qualified with a schema.
+func quoteTable(table string) string {
+	parts := strings.Split(table, ".")
+	for i, part := range parts {
+		parts[i] = pq.QuoteIdentifier(part)
+	}
+
+	return strings.Join(parts, ".")
+}
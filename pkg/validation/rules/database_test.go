@@ -0,0 +1,202 @@
+package rules
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestExistenceQuery(t *testing.T) {
+	tableTests := []struct {
+		name      string
+		table     string
+		column    string
+		options   []QueryOption
+		wantQuery string
+		wantArgs  []any
+	}{
+		{
+			"Column only", "users", "email", nil,
+			`SELECT EXISTS (SELECT 1 FROM "users" WHERE "email" = $1)`, nil,
+		},
+		{
+			"Schema and conditions", "public.goals", "title",
+			[]QueryOption{Where("user_id", 7), WhereNull("deleted_at"), Ignore("id", 42)},
+			`SELECT EXISTS (SELECT 1 FROM "public"."goals" WHERE "title" = $1 AND "user_id" = $2 AND "deleted_at" IS NULL AND "id" <> $3)`,
+			[]any{7, 42},
+		},
+		{
+			"Quoted identifiers", `users"; DROP TABLE users; --`, "email", nil,
+			`SELECT EXISTS (SELECT 1 FROM "users""; DROP TABLE users; --" WHERE "email" = $1)`, nil,
+		},
+	}
+
+	for _, tt := range tableTests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, args := existenceQuery(tt.table, tt.column, tt.options)
+			if query != tt.wantQuery {
+				t.Errorf("got %q, want %q", query, tt.wantQuery)
+			}
+
+			if !reflect.DeepEqual(args, tt.wantArgs) {
+				t.Errorf("got %v, want %v", args, tt.wantArgs)
+			}
+		})
+	}
+}
+
+// fakeDatabase answers the existence queries with the values it holds, recording the arguments it receives.
+type fakeDatabase struct {
+	values []any
+	err    error
+	args   [][]driver.Value
+}
+
+func (database *fakeDatabase) Connect(ctx context.Context) (driver.Conn, error) {
+	return fakeConn{database}, nil
+}
+
+func (database *fakeDatabase) Driver() driver.Driver {
+	return nil
+}
+
+type fakeConn struct {
+	database *fakeDatabase
+}
+
+func (conn fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return nil, errors.New("prepared statements are not supported")
+}
+
+func (conn fakeConn) Close() error {
+	return nil
+}
+
+func (conn fakeConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("transactions are not supported")
+}
+
+func (conn fakeConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	if conn.database.err != nil {
+		return nil, conn.database.err
+	}
+
+	values := make([]driver.Value, len(args))
+	for i, arg := range args {
+		values[i] = arg.Value
+	}
+
+	conn.database.args = append(conn.database.args, values)
+
+	return &fakeRows{exists: slices.Contains(conn.database.values, any(values[0]))}, nil
+}
+
+type fakeRows struct {
+	exists bool
+	read   bool
+}
+
+func (rows *fakeRows) Columns() []string {
+	return []string{"exists"}
+}
+
+func (rows *fakeRows) Close() error {
+	return nil
+}
+
+func (rows *fakeRows) Next(dest []driver.Value) error {
+	if rows.read {
+		return io.EOF
+	}
+
+	rows.read = true
+	dest[0] = rows.exists
+
+	return nil
+}
+
+func TestDatabaseRules(t *testing.T) {
+	failure := errors.New("connection refused")
+	tableTests := []struct {
+		name     string
+		ruleFunc func(db Querier) ContextRuleFunc
+		values   []any
+		err      error
+		data     map[string]any
+		want     string
+		wantErr  error
+		wantArgs [][]driver.Value
+	}{
+		{
+			"Unique value", func(db Querier) ContextRuleFunc { return Unique(db, "users", "email") },
+			[]any{"arthur@camelot.uk"}, nil, map[string]any{"test": "merlin@camelot.uk"}, noError, nil,
+			[][]driver.Value{{"merlin@camelot.uk"}},
+		},
+		{
+			"Taken value", func(db Querier) ContextRuleFunc { return Unique(db, "users", "email") },
+			[]any{"merlin@camelot.uk"}, nil, map[string]any{"test": "merlin@camelot.uk"}, "The test has already been taken", nil,
+			[][]driver.Value{{"merlin@camelot.uk"}},
+		},
+		{
+			"Unique value of the ignored record", func(db Querier) ContextRuleFunc { return Unique(db, "users", "email", Ignore("id", 7)) },
+			nil, nil, map[string]any{"test": "merlin@camelot.uk"}, noError, nil,
+			[][]driver.Value{{"merlin@camelot.uk", int64(7)}},
+		},
+		{
+			"Existing value", func(db Querier) ContextRuleFunc { return Exists(db, "goals", "id") },
+			[]any{int64(42)}, nil, map[string]any{"test": int64(42)}, noError, nil,
+			[][]driver.Value{{int64(42)}},
+		},
+		{
+			"Missing value", func(db Querier) ContextRuleFunc { return Exists(db, "goals", "id") },
+			[]any{int64(42)}, nil, map[string]any{"test": int64(43)}, "The selected test is invalid", nil,
+			[][]driver.Value{{int64(43)}},
+		},
+		{
+			"Null value", func(db Querier) ContextRuleFunc { return Exists(db, "goals", "id") },
+			nil, nil, map[string]any{"test": nil}, noError, nil, nil,
+		},
+		{
+			"Object value", func(db Querier) ContextRuleFunc { return Unique(db, "users", "email") },
+			nil, nil, map[string]any{"test": map[string]any{"email": "merlin@camelot.uk"}}, "The test field must be a string, a number or a boolean", nil, nil,
+		},
+		{
+			"Array value", func(db Querier) ContextRuleFunc { return Exists(db, "goals", "id") },
+			nil, nil, map[string]any{"test": []any{int64(42)}}, "The test field must be a string, a number or a boolean", nil, nil,
+		},
+		{
+			"Absent value", func(db Querier) ContextRuleFunc { return Unique(db, "users", "email") },
+			nil, nil, map[string]any{}, noError, nil, nil,
+		},
+		{
+			"Database error", func(db Querier) ContextRuleFunc { return Unique(db, "users", "email") },
+			nil, failure, map[string]any{"test": "merlin@camelot.uk"}, noError, failure, nil,
+		},
+	}
+
+	for _, tt := range tableTests {
+		t.Run(tt.name, func(t *testing.T) {
+			database := &fakeDatabase{values: tt.values, err: tt.err}
+			db := sql.OpenDB(database)
+			defer db.Close()
+
+			got, err := tt.ruleFunc(db)(context.Background(), tt.data, "test")
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+
+			if got.String() != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+
+			if !reflect.DeepEqual(database.args, tt.wantArgs) {
+				t.Errorf("got %v, want %v", database.args, tt.wantArgs)
+			}
+		})
+	}
+}
@@ -0,0 +1,231 @@
+package rules
+
+import (
+	"github.com/EugeneNail/motivatr-lib-common/pkg/validation/messages"
+	"regexp"
+	"time"
+)
+
+// DateFormat checks that the field is a string matching the time layout, e.g. "02.01.2006".
+// Absent and null fields and empty strings pass, like in CompiledRegex, while values of other types fail.
+func DateFormat(layout string) Rule {
+	params := map[string]any{"format": layout}
+
+	return described(Descriptor{Name: "date_format", Params: params}, func(data map[string]any, field string) (*messages.Message, error) {
+		value, exists := data[field]
+		if !exists || value == nil {
+			return nil, nil
+		}
+
+		text, ok := value.(string)
+		if !ok {
+			return messages.New("date_format", field, params), nil
+		}
+
+		if len(text) == 0 {
+			return nil, nil
+		}
+
+		if _, err := time.Parse(layout, text); err != nil {
+			return messages.New("date_format", field, params), nil
+		}
+
+		return nil, nil
+	})
+}
+
+// TimePattern is the 24-hour time without seconds accepted by Time, e.g. "09:30".
+const TimePattern = `^([01][0-9]|2[0-3]):[0-5][0-9]$`
+
+var timeRegex = regexp.MustCompile(TimePattern)
+
+// DateTime checks that the field is an RFC 3339 date and time with an offset, e.g. "2025-01-01T09:00:00+03:00".
+func DateTime() Rule {
+	return layoutRule("date_time", func(text string) bool {
+		_, err := time.Parse(time.RFC3339, text)
+		return err == nil
+	})
+}
+
+// Time checks that the field is a 24-hour time without seconds in the HH:MM format, e.g. "09:30" but not "9:30".
+func Time() Rule {
+	return layoutRule("time", timeRegex.MatchString)
+}
+
+func layoutRule(key string, valid func(text string) bool) Rule {
+	return described(Descriptor{Name: key}, func(data map[string]any, field string) (*messages.Message, error) {
+		value, exists := data[field]
+		if !exists {
+			return nil, nil
+		}
+
+		text, ok := value.(string)
+		if !ok || !valid(text) {
+			return messages.New(key, field, nil), nil
+		}
+
+		return nil, nil
+	})
+}
+
+// Before checks that the field is a date or a time before the reference. The reference is "now", "today",
+// "yesterday", "tomorrow", a date in the 2006-01-02 or RFC 3339 formats, or the name of another field.
+// Values with offsets are compared as instants, values without offsets are treated as UTC, see BeforeIn.
+// Dates of other layouts are compared by BeforeInLayout.
+// The rule passes when the field or the referenced field is absent.
+func Before(reference string) Rule {
+	return BeforeIn(reference, time.UTC)
+}
+
+func BeforeOrEqual(reference string) Rule {
+	return BeforeOrEqualIn(reference, time.UTC)
+}
+
+func After(reference string) Rule {
+	return AfterIn(reference, time.UTC)
+}
+
+func AfterOrEqual(reference string) Rule {
+	return AfterOrEqualIn(reference, time.UTC)
+}
+
+// BeforeIn is Before in the location: "today", "yesterday" and "tomorrow" start at the midnight of the location,
+// and values without offsets are local times of the location, e.g. BeforeIn("today", moscow) rejects a date
+// of the current Moscow day even while it is still yesterday in UTC.
+func BeforeIn(reference string, location *time.Location) Rule {
+	return compareWithDate(reference, comparableLayouts, location, "before", func(comparison int) bool { return comparison < 0 })
+}
+
+func BeforeOrEqualIn(reference string, location *time.Location) Rule {
+	return compareWithDate(reference, comparableLayouts, location, "before_or_equal", func(comparison int) bool { return comparison <= 0 })
+}
+
+func AfterIn(reference string, location *time.Location) Rule {
+	return compareWithDate(reference, comparableLayouts, location, "after", func(comparison int) bool { return comparison > 0 })
+}
+
+func AfterOrEqualIn(reference string, location *time.Location) Rule {
+	return compareWithDate(reference, comparableLayouts, location, "after_or_equal", func(comparison int) bool { return comparison >= 0 })
+}
+
+// BeforeInLayout is BeforeIn for dates in the layout of DateFormat, e.g. BeforeInLayout("today", "02.01.2006", time.UTC)
+// accepts "01.02.1990". The value, a fixed reference and the referenced field may also be in the layouts of Before.
+func BeforeInLayout(reference string, layout string, location *time.Location) Rule {
+	return compareWithDate(reference, withLayout(layout), location, "before", func(comparison int) bool { return comparison < 0 })
+}
+
+func BeforeOrEqualInLayout(reference string, layout string, location *time.Location) Rule {
+	return compareWithDate(reference, withLayout(layout), location, "before_or_equal", func(comparison int) bool { return comparison <= 0 })
+}
+
+func AfterInLayout(reference string, layout string, location *time.Location) Rule {
+	return compareWithDate(reference, withLayout(layout), location, "after", func(comparison int) bool { return comparison > 0 })
+}
+
+func AfterOrEqualInLayout(reference string, layout string, location *time.Location) Rule {
+	return compareWithDate(reference, withLayout(layout), location, "after_or_equal", func(comparison int) bool { return comparison >= 0 })
+}
+
+func withLayout(layout string) []string {
+	return append([]string{layout}, comparableLayouts...)
+}
+
+func compareWithDate(reference string, layouts []string, location *time.Location, key string, passes func(comparison int) bool) Rule {
+	resolve := dateReference(reference, layouts, location)
+	params := map[string]any{"date": reference}
+	if _, isLiteral := relativeDates[reference]; !isLiteral {
+		if _, isDate := toTimeIn(reference, layouts, location); !isDate {
+			params["date"] = messages.Field(reference)
+		}
+	}
+
+	return described(Descriptor{Name: key, Params: map[string]any{"date": reference}}, func(data map[string]any, field string) (*messages.Message, error) {
+		value, exists := data[field]
+		if !exists || value == nil {
+			return nil, nil
+		}
+
+		date, ok := toTimeIn(value, layouts, location)
+		if !ok {
+			return messages.New("date", field, nil), nil
+		}
+
+		referenceDate, ok := resolve(data)
+		if !ok {
+			return nil, nil
+		}
+
+		if !passes(date.Compare(referenceDate)) {
+			return messages.New(key, field, params), nil
+		}
+
+		return nil, nil
+	})
+}
+
+var relativeDates = map[string]func(now time.Time) time.Time{
+	"now": func(now time.Time) time.Time {
+		return now
+	},
+	"today": func(now time.Time) time.Time {
+		return midnight(now, 0)
+	},
+	"yesterday": func(now time.Time) time.Time {
+		return midnight(now, -1)
+	},
+	"tomorrow": func(now time.Time) time.Time {
+		return midnight(now, 1)
+	},
+}
+
+// midnight returns the start of the day shifted by the days in the location of the time.
+func midnight(now time.Time, days int) time.Time {
+	year, month, day := now.Date()
+	return time.Date(year, month, day+days, 0, 0, 0, 0, now.Location())
+}
+
+func dateReference(reference string, layouts []string, location *time.Location) func(data map[string]any) (time.Time, bool) {
+	if relative, exists := relativeDates[reference]; exists {
+		return func(data map[string]any) (time.Time, bool) {
+			return relative(time.Now().In(location)), true
+		}
+	}
+
+	if date, ok := toTimeIn(reference, layouts, location); ok {
+		return func(data map[string]any) (time.Time, bool) {
+			return date, true
+		}
+	}
+
+	return func(data map[string]any) (time.Time, bool) {
+		value, exists := data[reference]
+		if !exists || value == nil {
+			return time.Time{}, false
+		}
+
+		return toTimeIn(value, layouts, location)
+	}
+}
+
+var comparableLayouts = []string{time.RFC3339Nano, "2006-01-02T15:04:05", "2006-01-02 15:04:05", "2006-01-02"}
+
+func toTime(value any) (time.Time, bool) {
+	return toTimeIn(value, comparableLayouts, time.UTC)
+}
+
+// toTimeIn parses the value in the first matching layout, treating dates and times without offsets as the ones
+// of the location.
+func toTimeIn(value any, layouts []string, location *time.Location) (time.Time, bool) {
+	switch typedValue := value.(type) {
+	case time.Time:
+		return typedValue, true
+	case string:
+		for _, layout := range layouts {
+			if parsed, err := time.ParseInLocation(layout, typedValue, location); err == nil {
+				return parsed, true
+			}
+		}
+	}
+
+	return time.Time{}, false
+}
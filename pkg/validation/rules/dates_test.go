@@ -0,0 +1,90 @@
+package rules
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDateFormats(t *testing.T) {
+	tableTests := []struct {
+		name     string
+		ruleFunc Rule
+		data     map[string]any
+		want     string
+	}{
+		{"Custom layout", DateFormat("02.01.2006"), map[string]any{"test": "31.12.1987"}, noError},
+		{"Custom layout mismatch", DateFormat("02.01.2006"), map[string]any{"test": "1987-12-31"}, "The test field must match the format 02.01.2006"},
+		{"Custom layout not a string", DateFormat("02.01.2006"), map[string]any{"test": 1987}, "The test field must match the format 02.01.2006"},
+		{"Custom layout missing field", DateFormat("02.01.2006"), map[string]any{}, noError},
+		{"Custom layout null field", DateFormat("02.01.2006"), map[string]any{"test": nil}, noError},
+		{"Custom layout empty string", DateFormat("02.01.2006"), map[string]any{"test": ""}, noError},
+
+		{"Date time with an offset", DateTime(), map[string]any{"test": "2025-03-01T09:00:00+03:00"}, noError},
+		{"Date time in UTC", DateTime(), map[string]any{"test": "2025-03-01T09:00:00Z"}, noError},
+		{"Date time without an offset", DateTime(), map[string]any{"test": "2025-03-01T09:00:00"}, "The test field must be a valid date and time"},
+		{"Date only", DateTime(), map[string]any{"test": "2025-03-01"}, "The test field must be a valid date and time"},
+
+		{"Time", Time(), map[string]any{"test": "09:30"}, noError},
+		{"Midnight", Time(), map[string]any{"test": "00:00"}, noError},
+		{"Time out of range", Time(), map[string]any{"test": "24:00"}, "The test field must be a valid time"},
+		{"Time with seconds", Time(), map[string]any{"test": "09:30:15"}, "The test field must be a valid time"},
+		{"Time with a single digit hour", Time(), map[string]any{"test": "9:30"}, "The test field must be a valid time"},
+		{"Time with a single digit minute", Time(), map[string]any{"test": "09:3"}, "The test field must be a valid time"},
+	}
+
+	for _, tt := range tableTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, _ := tt.ruleFunc.Check(tt.data, "test"); got.String() != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDateComparisons(t *testing.T) {
+	yesterday := time.Now().UTC().AddDate(0, 0, -1).Format("2006-01-02")
+	tomorrow := time.Now().UTC().AddDate(0, 0, 1).Format("2006-01-02")
+	moscow := time.FixedZone("MSK", 3*60*60)
+	moscowToday := time.Now().In(moscow).Format("2006-01-02")
+	moscowYesterday := time.Now().In(moscow).AddDate(0, 0, -1).Format("2006-01-02")
+
+	tableTests := []struct {
+		name     string
+		ruleFunc Rule
+		data     map[string]any
+		want     string
+	}{
+		{"Birthday before the century", Before("2000-01-01"), map[string]any{"test": "1987-12-31"}, noError},
+		{"Date before a fixed date", Before("2025-01-01"), map[string]any{"test": "2025-01-01"}, "The test field must be a date before 2025-01-01"},
+		{"Date before or equal to a fixed date", BeforeOrEqual("2025-01-01"), map[string]any{"test": "2025-01-01"}, noError},
+		{"Past date before today", Before("today"), map[string]any{"test": yesterday}, noError},
+		{"Future date before now", Before("now"), map[string]any{"test": tomorrow}, "The test field must be a date before now"},
+		{"Future date after today", After("today"), map[string]any{"test": tomorrow}, noError},
+		{"Date after another field", After("start"), map[string]any{"test": "2025-01-02", "start": "2025-01-01"}, noError},
+		{"Date not after another field", After("start"), map[string]any{"test": "2025-01-01", "start": "2025-01-01"}, "The test field must be a date after start"},
+		{"Date after or equal to another field", AfterOrEqual("start"), map[string]any{"test": "2025-01-01", "start": "2025-01-01"}, noError},
+		{"Missing referenced field", After("start"), map[string]any{"test": "2025-01-01"}, noError},
+		{"Earlier instant in another timezone", Before("2025-01-01T00:00:00Z"), map[string]any{"test": "2025-01-01T02:00:00+03:00"}, noError},
+		{"Later instant in another timezone", Before("2025-01-01T00:00:00+03:00"), map[string]any{"test": "2024-12-31T22:00:00Z"}, "The test field must be a date before 2025-01-01T00:00:00+03:00"},
+		{"Time value", After("2025-01-01"), map[string]any{"test": time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)}, noError},
+		{"Invalid value", After("2025-01-01"), map[string]any{"test": "tomorrow"}, "The test field format is invalid"},
+		{"Local date in a location", AfterIn("2025-01-01T00:00:00Z", moscow), map[string]any{"test": "2025-01-01 02:00:00"}, "The test field must be a date after 2025-01-01T00:00:00Z"},
+		{"Local date in UTC", After("2025-01-01T00:00:00Z"), map[string]any{"test": "2025-01-01 02:00:00"}, noError},
+		{"Today in a location", AfterOrEqualIn("today", moscow), map[string]any{"test": moscowToday}, noError},
+		{"Yesterday in a location", BeforeIn("today", moscow), map[string]any{"test": moscowYesterday}, noError},
+		{"Today before today in a location", BeforeIn("today", moscow), map[string]any{"test": moscowToday}, "The test field must be a date before today"},
+		{"Date of a layout before today", BeforeInLayout("today", "02.01.2006", time.UTC), map[string]any{"test": "01.02.1990"}, noError},
+		{"Date of a layout after today", AfterInLayout("today", "02.01.2006", time.UTC), map[string]any{"test": "01.02.1990"}, "The test field must be a date after today"},
+		{"Date of a layout after a date of the layout", AfterOrEqualInLayout("01.01.2000", "02.01.2006", time.UTC), map[string]any{"test": "01.02.1990"}, "The test field must be a date after or equal to 01.01.2000"},
+		{"Date of a layout before a field", BeforeOrEqualInLayout("end", "02.01.2006", time.UTC), map[string]any{"test": "01.02.1990", "end": "1990-02-01"}, noError},
+		{"Date of another layout", BeforeInLayout("today", "02.01.2006", time.UTC), map[string]any{"test": "1990/02/01"}, "The test field format is invalid"},
+	}
+
+	for _, tt := range tableTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, _ := tt.ruleFunc.Check(tt.data, "test"); got.String() != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package rules
+
+import (
+	"github.com/EugeneNail/motivatr-lib-common/pkg/validation/messages"
+)
+
+// Descriptor describes a built-in rule, e.g. {Name: "max", Params: {"limit": 255}}, so that rule sets can be
+// turned into documentation or schemas. Names match the message keys without the kind suffix.
+type Descriptor struct {
+	Name     string
+	Params   map[string]any
+	Children []Descriptor
+}
+
+// Described is a rule carrying the descriptors of its configuration. Built-in rules are Described,
+// and so may be custom rules that should appear in schemas.
+type Described struct {
+	Descriptors []Descriptor
+	Func        RuleFunc
+}
+
+func (rule Described) Check(data map[string]any, field string) (*messages.Message, error) {
+	return rule.Func(data, field)
+}
+
+// Describe returns the descriptors of the built-in rules the rule consists of.
+// Other rules are never called and yield no descriptors.
+func Describe(rule Rule) []Descriptor {
+	if described, isDescribed := rule.(Described); isDescribed {
+		return described.Descriptors
+	}
+
+	return nil
+}
+
+func described(descriptor Descriptor, ruleFunc RuleFunc) Rule {
+	return Described{Descriptors: []Descriptor{descriptor}, Func: ruleFunc}
+}
+
+// describedAll makes the rule report the descriptors, e.g. the ones of the rules a combinator passes the field to.
+func describedAll(descriptors []Descriptor, ruleFunc RuleFunc) Rule {
+	return Described{Descriptors: descriptors, Func: ruleFunc}
+}
@@ -0,0 +1,55 @@
+package rules
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/EugeneNail/motivatr-lib-common/pkg/validation/messages"
+)
+
+func TestDescribe(t *testing.T) {
+	custom := RuleFunc(func(data map[string]any, field string) (*messages.Message, error) {
+		return messages.New("custom", field, nil), nil
+	})
+
+	tableTests := []struct {
+		name     string
+		ruleFunc Rule
+		want     []Descriptor
+	}{
+		{"Required", Required(), []Descriptor{{Name: "required"}}},
+		{"Max", Max(255), []Descriptor{{Name: "max", Params: map[string]any{"limit": 255}}}},
+		{"Regex", Regex(Alpha), []Descriptor{{Name: "regex", Params: map[string]any{"pattern": Alpha}}}},
+		{"In", In("daily", "weekly"), []Descriptor{{Name: "in", Params: map[string]any{"values": []any{"daily", "weekly"}}}}},
+		{"Field comparison", GreaterThanField("start"), []Descriptor{{Name: "gt", Params: map[string]any{"other": "start"}}}},
+		{"Custom rule", custom, nil},
+		{"Described custom rule", Described{Descriptors: []Descriptor{{Name: "custom"}}, Func: custom}, []Descriptor{{Name: "custom"}}},
+		{"Nil rule", nil, nil},
+	}
+
+	for _, tt := range tableTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Describe(tt.ruleFunc); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDescribeDoesNotCallCustomRules(t *testing.T) {
+	calls := 0
+	custom := RuleFunc(func(data map[string]any, field string) (*messages.Message, error) {
+		calls++
+		return messages.New("custom", field, map[string]any{"length": len(data[field].(string))}), nil
+	})
+
+	got := Describe(AllOf(Required(), custom, Each(custom)))
+	want := []Descriptor{{Name: "required"}, {Name: "each"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+
+	if calls != 0 {
+		t.Errorf("got %d calls, want 0", calls)
+	}
+}
@@ -0,0 +1,207 @@
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"github.com/EugeneNail/motivatr-lib-common/pkg/validation/messages"
+	"reflect"
+	"sort"
+)
+
+// Each applies the rules to every item of a slice or an array. Failures are reported under the paths
+// of the items, e.g. "tags.2", as children of an "each" message.
+func Each(ruleFuncs ...Rule) Rule {
+	descriptor := Descriptor{Name: "each", Children: describeAll(ruleFuncs)}
+
+	return described(descriptor, func(data map[string]any, field string) (*messages.Message, error) {
+		reflected := reflect.ValueOf(data[field])
+		if reflected.Kind() != reflect.Slice && reflected.Kind() != reflect.Array {
+			return nil, nil
+		}
+
+		var elements []element
+		for i := 0; i < reflected.Len(); i++ {
+			elements = append(elements, element{fmt.Sprint(i), reflected.Index(i).Interface()})
+		}
+
+		return validateElements(data, field, elements, ruleFuncs)
+	})
+}
+
+// Keys applies the rules to every key of a map. Failures are reported under the paths of the keys, e.g. "meta.color".
+func Keys(ruleFuncs ...Rule) Rule {
+	descriptor := Descriptor{Name: "keys", Children: describeAll(ruleFuncs)}
+
+	return described(descriptor, func(data map[string]any, field string) (*messages.Message, error) {
+		var elements []element
+		for _, entry := range mapEntries(data[field]) {
+			elements = append(elements, element{entry.key, entry.mapKey})
+		}
+
+		return validateElements(data, field, elements, ruleFuncs)
+	})
+}
+
+// Values applies the rules to every value of a map. Failures are reported under the paths of the values, e.g. "meta.color".
+func Values(ruleFuncs ...Rule) Rule {
+	descriptor := Descriptor{Name: "values", Children: describeAll(ruleFuncs)}
+
+	return described(descriptor, func(data map[string]any, field string) (*messages.Message, error) {
+		var elements []element
+		for _, entry := range mapEntries(data[field]) {
+			elements = append(elements, element{entry.key, entry.value})
+		}
+
+		return validateElements(data, field, elements, ruleFuncs)
+	})
+}
+
+// Distinct checks that a slice or an array has no duplicate items. Numbers of different types are equal
+// when they hold the same value.
+func Distinct() Rule {
+	return described(Descriptor{Name: "distinct"}, func(data map[string]any, field string) (*messages.Message, error) {
+		reflected := reflect.ValueOf(data[field])
+		if reflected.Kind() != reflect.Slice && reflected.Kind() != reflect.Array {
+			return nil, nil
+		}
+
+		seen := make(map[any]bool)
+		var uncomparable []any
+		for _, value := range items(data[field]) {
+			if value != nil && !reflect.TypeOf(value).Comparable() {
+				for _, other := range uncomparable {
+					if reflect.DeepEqual(value, other) {
+						return messages.New("distinct", field, nil), nil
+					}
+				}
+
+				uncomparable = append(uncomparable, value)
+				continue
+			}
+
+			key := distinctKey(value)
+			if seen[key] {
+				return messages.New("distinct", field, nil), nil
+			}
+
+			seen[key] = true
+		}
+
+		return nil, nil
+	})
+}
+
+// numberKey identifies a number regardless of its type, so that 1 and float64(1) are duplicates while "1" is not.
+type numberKey string
+
+// distinctKey normalizes the comparable value once, so that Distinct finds duplicates with a map.
+func distinctKey(value any) any {
+	if number, isNumber := toRat(value); isNumber {
+		return numberKey(number.RatString())
+	}
+
+	return value
+}
+
+type element struct {
+	key   string
+	value any
+}
+
+type mapEntry struct {
+	key    string
+	mapKey any
+	value  any
+}
+
+func mapEntries(value any) []mapEntry {
+	reflected := reflect.ValueOf(value)
+	if reflected.Kind() != reflect.Map {
+		return nil
+	}
+
+	entries := make([]mapEntry, 0, reflected.Len())
+	iterator := reflected.MapRange()
+	for iterator.Next() {
+		key := iterator.Key().Interface()
+		entries = append(entries, mapEntry{fmt.Sprint(key), key, iterator.Value().Interface()})
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].key < entries[j].key
+	})
+
+	return entries
+}
+
+// validateElements runs the rules against every element placed into the data under its path, so that the rules
+// can still see the sibling fields. The element keys are removed or restored afterwards instead of copying the data
+// for every field, which would make nested Each rules quadratic.
+func validateElements(data map[string]any, field string, elements []element, ruleFuncs []Rule) (*messages.Message, error) {
+	if len(elements) == 0 {
+		return nil, nil
+	}
+
+	type previousValue struct {
+		value  any
+		exists bool
+	}
+
+	previous := make(map[string]previousValue, len(elements))
+	for _, element := range elements {
+		key := field + "." + element.key
+		value, exists := data[key]
+		previous[key] = previousValue{value, exists}
+		data[key] = element.value
+	}
+
+	defer func() {
+		for key, value := range previous {
+			if value.exists {
+				data[key] = value.value
+			} else {
+				delete(data, key)
+			}
+		}
+	}()
+
+	var children []*messages.Message
+	for _, element := range elements {
+		elementField := field + "." + element.key
+
+		for _, ruleFunc := range ruleFuncs {
+			message, err := ruleFunc.Check(data, elementField)
+			if errors.Is(err, ErrSkip) {
+				break
+			}
+
+			if err != nil {
+				return nil, fmt.Errorf("validating %s: %w", elementField, err)
+			}
+
+			if message != nil {
+				if len(message.Field) == 0 {
+					message.Field = elementField
+				}
+
+				children = append(children, message)
+				break
+			}
+		}
+	}
+
+	if len(children) == 0 {
+		return nil, nil
+	}
+
+	return &messages.Message{Key: "each", Field: field, Children: children}, nil
+}
+
+func describeAll(ruleFuncs []Rule) []Descriptor {
+	var descriptors []Descriptor
+	for _, ruleFunc := range ruleFuncs {
+		descriptors = append(descriptors, Describe(ruleFunc)...)
+	}
+
+	return descriptors
+}
@@ -0,0 +1,132 @@
+package rules
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/EugeneNail/motivatr-lib-common/pkg/validation/messages"
+)
+
+func TestEach(t *testing.T) {
+	ruleFunc := Each(Required(), Max(5))
+	tableTests := []struct {
+		name string
+		data map[string]any
+		want map[string]string
+	}{
+		{"Missing field", map[string]any{}, nil},
+		{"Valid items", map[string]any{"test": []string{"books", "sport"}}, nil},
+		{"Invalid items", map[string]any{"test": []any{"books", "", "reading"}}, map[string]string{
+			"test.1": "The test.1 field is required",
+			"test.2": "The test.2 field must not be greater than 5 characters",
+		}},
+		{"Array", map[string]any{"test": [2]int{3, 7}}, map[string]string{"test.1": "The test.1 field must not be greater than 5"}},
+		{"Not a slice", map[string]any{"test": "books"}, nil},
+	}
+
+	for _, tt := range tableTests {
+		t.Run(tt.name, func(t *testing.T) {
+			message, err := ruleFunc.Check(tt.data, "test")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			assertChildren(t, message, tt.want)
+		})
+	}
+}
+
+func TestEachRestoresTheData(t *testing.T) {
+	data := map[string]any{"test": []any{"books", "sport"}, "test.0": "books"}
+
+	if _, err := Each(Max(5)).Check(data, "test"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := map[string]any{"test": []any{"books", "sport"}, "test.0": "books"}
+	if !reflect.DeepEqual(data, want) {
+		t.Errorf("got %v, want %v", data, want)
+	}
+}
+
+func TestKeysAndValues(t *testing.T) {
+	data := map[string]any{"test": map[string]any{"color": "green", "Size": "extra large"}}
+
+	message, err := Keys(Regex(`^[a-z]+$`)).Check(data, "test")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	assertChildren(t, message, map[string]string{"test.Size": "The test.Size field format is invalid"})
+
+	message, err = Values(Max(5)).Check(data, "test")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	assertChildren(t, message, map[string]string{"test.Size": "The test.Size field must not be greater than 5 characters"})
+}
+
+func TestDistinct(t *testing.T) {
+	tableTests := []struct {
+		name string
+		data map[string]any
+		want string
+	}{
+		{"Missing field", map[string]any{}, noError},
+		{"Distinct strings", map[string]any{"test": []string{"books", "sport"}}, noError},
+		{"Duplicate strings", map[string]any{"test": []string{"books", "sport", "books"}}, "The test field has a duplicate value"},
+		{"Duplicate numbers of different types", map[string]any{"test": []any{1, int64(2), float64(1)}}, "The test field has a duplicate value"},
+		{"Number and a numeric string", map[string]any{"test": []any{1, "1"}}, noError},
+		{"Duplicate json numbers", map[string]any{"test": []any{json.Number("0.5"), 0.5}}, "The test field has a duplicate value"},
+		{"Distinct maps", map[string]any{"test": []any{map[string]any{"id": 1}, map[string]any{"id": 2}}}, noError},
+		{"Duplicate maps", map[string]any{"test": []any{map[string]any{"id": 1}, map[string]any{"id": 1}}}, "The test field has a duplicate value"},
+	}
+
+	for _, tt := range tableTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, _ := Distinct().Check(tt.data, "test"); got.String() != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDistinctLargeArray(t *testing.T) {
+	values := make([]any, 20000)
+	for i := range values {
+		values[i] = int64(i)
+	}
+
+	start := time.Now()
+	if got, _ := Distinct().Check(map[string]any{"test": values}, "test"); got != nil {
+		t.Fatalf("got %q, want no error", got)
+	}
+
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("took %v to validate 20000 items", elapsed)
+	}
+}
+
+func assertChildren(t *testing.T, message *messages.Message, want map[string]string) {
+	t.Helper()
+
+	got := make(map[string]string)
+	if message != nil {
+		for _, child := range message.Children {
+			got[child.Field] = child.String()
+		}
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	for field, text := range want {
+		if got[field] != text {
+			t.Errorf("%s: got %q, want %q", field, got[field], text)
+		}
+	}
+}
@@ -0,0 +1,153 @@
+package rules
+
+import (
+	"fmt"
+	"github.com/EugeneNail/motivatr-lib-common/pkg/validation/messages"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"path/filepath"
+	"strings"
+)
+
+// File checks that the field is an uploaded file, e.g. a value of the data built by validation.DecodeMultipartForm.
+func File() Rule {
+	return fileRule(Descriptor{Name: "file"}, nil)
+}
+
+// MaxFileSize checks that the uploaded file is not larger than the number of bytes.
+func MaxFileSize(bytes int64) Rule {
+	params := map[string]any{"size": formatBytes(bytes)}
+
+	return fileRule(Descriptor{Name: "max_file_size", Params: map[string]any{"bytes": bytes}}, func(header *multipart.FileHeader, field string) (*messages.Message, error) {
+		if header.Size > bytes {
+			return messages.New("max_file_size", field, params), nil
+		}
+
+		return nil, nil
+	})
+}
+
+// MimeTypes checks the type of the uploaded file detected from its content with http.DetectContentType,
+// so that a renamed file does not pass. Types may end with a wildcard, e.g. MimeTypes("image/*", "application/pdf").
+func MimeTypes(types ...string) Rule {
+	params := map[string]any{"values": strings.Join(types, ", ")}
+
+	return fileRule(Descriptor{Name: "mime_types", Params: map[string]any{"types": types}}, func(header *multipart.FileHeader, field string) (*messages.Message, error) {
+		detected, err := detectContentType(header)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, allowed := range types {
+			if detected == allowed || strings.HasSuffix(allowed, "/*") && strings.HasPrefix(detected, strings.TrimSuffix(allowed, "*")) {
+				return nil, nil
+			}
+		}
+
+		return messages.New("mime_types", field, params), nil
+	})
+}
+
+// Extensions checks the extension of the name of the uploaded file case-insensitively, e.g. Extensions("jpg", "png").
+// The extension is given by the client, use MimeTypes to check the content.
+func Extensions(extensions ...string) Rule {
+	params := map[string]any{"values": strings.Join(extensions, ", ")}
+
+	return fileRule(Descriptor{Name: "extensions", Params: map[string]any{"extensions": extensions}}, func(header *multipart.FileHeader, field string) (*messages.Message, error) {
+		extension := strings.TrimPrefix(filepath.Ext(header.Filename), ".")
+		for _, allowed := range extensions {
+			if strings.EqualFold(extension, strings.TrimPrefix(allowed, ".")) {
+				return nil, nil
+			}
+		}
+
+		return messages.New("extensions", field, params), nil
+	})
+}
+
+// Image checks that the uploaded file is a gif, jpeg or png image within the dimensions. Zero means no limit.
+// Only the header of the image is decoded.
+func Image(maxWidth int, maxHeight int) Rule {
+	descriptor := Descriptor{Name: "image", Params: map[string]any{"max_width": maxWidth, "max_height": maxHeight}}
+
+	return fileRule(descriptor, func(header *multipart.FileHeader, field string) (*messages.Message, error) {
+		file, err := header.Open()
+		if err != nil {
+			return nil, fmt.Errorf("opening the uploaded file: %w", err)
+		}
+		defer file.Close()
+
+		config, _, err := image.DecodeConfig(file)
+		if err != nil {
+			return messages.New("image", field, nil), nil
+		}
+
+		if maxWidth > 0 && config.Width > maxWidth {
+			return messages.New("image.width", field, map[string]any{"width": maxWidth}), nil
+		}
+
+		if maxHeight > 0 && config.Height > maxHeight {
+			return messages.New("image.height", field, map[string]any{"height": maxHeight}), nil
+		}
+
+		return nil, nil
+	})
+}
+
+// fileRule checks uploaded files with the function. Absent and null fields pass, while values of other types fail
+// with the file message.
+func fileRule(descriptor Descriptor, check func(header *multipart.FileHeader, field string) (*messages.Message, error)) Rule {
+	return described(descriptor, func(data map[string]any, field string) (*messages.Message, error) {
+		value, exists := data[field]
+		if !exists || value == nil {
+			return nil, nil
+		}
+
+		header, ok := value.(*multipart.FileHeader)
+		if !ok || header == nil {
+			return messages.New("file", field, nil), nil
+		}
+
+		if check == nil {
+			return nil, nil
+		}
+
+		return check(header, field)
+	})
+}
+
+func detectContentType(header *multipart.FileHeader) (string, error) {
+	file, err := header.Open()
+	if err != nil {
+		return "", fmt.Errorf("opening the uploaded file: %w", err)
+	}
+	defer file.Close()
+
+	buffer := make([]byte, 512)
+	read, err := io.ReadFull(file, buffer)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return "", fmt.Errorf("reading the uploaded file: %w", err)
+	}
+
+	contentType, _, _ := strings.Cut(http.DetectContentType(buffer[:read]), ";")
+
+	return contentType, nil
+}
+
+// formatBytes renders the size in the largest binary unit that divides it, e.g. "2 MB" or "1536 KB".
+func formatBytes(bytes int64) string {
+	units := []string{"B", "KB", "MB", "GB"}
+
+	unit := 0
+	for unit < len(units)-1 && bytes >= 1024 && bytes%1024 == 0 {
+		bytes /= 1024
+		unit++
+	}
+
+	return fmt.Sprintf("%d %s", bytes, units[unit])
+}
@@ -0,0 +1,80 @@
+package rules
+
+import (
+	"bytes"
+	"image"
+	"image/png"
+	"mime/multipart"
+	"testing"
+)
+
+func TestFileRules(t *testing.T) {
+	picture := fileHeader(t, "avatar.PNG", pngImage(t, 40, 20))
+	document := fileHeader(t, "avatar.png", []byte("%PDF-1.7 a document pretending to be an image"))
+
+	tableTests := []struct {
+		name     string
+		ruleFunc Rule
+		data     map[string]any
+		want     string
+	}{
+		{"Missing file", File(), map[string]any{}, noError},
+		{"Null file", File(), map[string]any{"test": nil}, noError},
+		{"File", File(), map[string]any{"test": picture}, noError},
+		{"String instead of a file", File(), map[string]any{"test": "avatar.png"}, "The test field must be a file"},
+		{"Small file", MaxFileSize(picture.Size), map[string]any{"test": picture}, noError},
+		{"Large file", MaxFileSize(2 * 1024), map[string]any{"test": fileHeader(t, "large.txt", make([]byte, 3*1024))}, "The test field must not be greater than 2 KB"},
+		{"Detected type", MimeTypes("image/png"), map[string]any{"test": picture}, noError},
+		{"Detected type matching a wildcard", MimeTypes("image/*"), map[string]any{"test": picture}, noError},
+		{"Renamed document", MimeTypes("image/*"), map[string]any{"test": document}, "The test field must be a file of type: image/*"},
+		{"Allowed extension", Extensions("jpg", "png"), map[string]any{"test": picture}, noError},
+		{"Unlisted extension", Extensions(".jpg"), map[string]any{"test": picture}, "The test field must have one of the following extensions: .jpg"},
+		{"Image within the dimensions", Image(40, 20), map[string]any{"test": picture}, noError},
+		{"Image without limits", Image(0, 0), map[string]any{"test": picture}, noError},
+		{"Too wide image", Image(39, 0), map[string]any{"test": picture}, "The test field must not be wider than 39 pixels"},
+		{"Too high image", Image(0, 19), map[string]any{"test": picture}, "The test field must not be higher than 19 pixels"},
+		{"Document instead of an image", Image(0, 0), map[string]any{"test": document}, "The test field must be an image"},
+	}
+
+	for _, tt := range tableTests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.ruleFunc.Check(tt.data, "test")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got.String() != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func pngImage(t *testing.T, width int, height int) []byte {
+	var buffer bytes.Buffer
+	if err := png.Encode(&buffer, image.NewGray(image.Rect(0, 0, width, height))); err != nil {
+		t.Fatal(err)
+	}
+
+	return buffer.Bytes()
+}
+
+func fileHeader(t *testing.T, name string, content []byte) *multipart.FileHeader {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+
+	part, err := writer.CreateFormFile("file", name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	part.Write(content)
+	writer.Close()
+
+	form, err := multipart.NewReader(&body, writer.Boundary()).ReadForm(1 << 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return form.File["file"][0]
+}
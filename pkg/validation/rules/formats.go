@@ -0,0 +1,158 @@
+package rules
+
+import (
+	"encoding/json"
+	"github.com/EugeneNail/motivatr-lib-common/pkg/validation/messages"
+	"net/netip"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var (
+	phonePattern    = `^\+[1-9][0-9]{1,14}$`
+	hexColorPattern = `^#([0-9a-fA-F]{3}|[0-9a-fA-F]{4}|[0-9a-fA-F]{6}|[0-9a-fA-F]{8})$`
+	slugPattern     = `^[a-z0-9]+(-[a-z0-9]+)*$`
+)
+
+// UUID checks that the field is a hyphenated UUID of the version, e.g. UUID(4). Version 0 accepts any version.
+func UUID(version int) Rule {
+	params := map[string]any{"version": version}
+
+	return formatRule(Descriptor{Name: "uuid", Params: params}, "uuid", func(value string) bool {
+		return isUUID(value, version)
+	})
+}
+
+// URL checks that the field is an absolute URL with a host and one of the schemes, http and https by default.
+func URL(schemes ...string) Rule {
+	if len(schemes) == 0 {
+		schemes = []string{"http", "https"}
+	}
+
+	return formatRule(Descriptor{Name: "url", Params: map[string]any{"schemes": schemes}}, "url", func(value string) bool {
+		parsed, err := url.Parse(value)
+		if err != nil || len(parsed.Host) == 0 {
+			return false
+		}
+
+		for _, scheme := range schemes {
+			if strings.EqualFold(parsed.Scheme, scheme) {
+				return true
+			}
+		}
+
+		return false
+	})
+}
+
+// IP checks that the field is an IPv4 or IPv6 address.
+func IP() Rule {
+	return formatRule(Descriptor{Name: "ip"}, "ip", func(value string) bool {
+		_, err := netip.ParseAddr(value)
+		return err == nil
+	})
+}
+
+// CIDR checks that the field is an IPv4 or IPv6 network in the CIDR notation, e.g. "192.168.0.0/16".
+func CIDR() Rule {
+	return formatRule(Descriptor{Name: "cidr"}, "cidr", func(value string) bool {
+		_, err := netip.ParsePrefix(value)
+		return err == nil
+	})
+}
+
+// Phone checks that the field is a phone number in the E.164 format, e.g. "+79990000000".
+func Phone() Rule {
+	return patternRule("phone", phonePattern)
+}
+
+// Timezone checks that the field is a name from the IANA time zone database, e.g. "Europe/Moscow".
+func Timezone() Rule {
+	return formatRule(Descriptor{Name: "timezone"}, "timezone", func(value string) bool {
+		if value == "Local" {
+			return false
+		}
+
+		_, err := time.LoadLocation(value)
+		return err == nil
+	})
+}
+
+// HexColor checks that the field is a color like "#fff", "#ffffff" or the same with an alpha channel.
+func HexColor() Rule {
+	return patternRule("hex_color", hexColorPattern)
+}
+
+// Slug checks that the field consists of lower case latin letters and numbers separated by single dashes.
+func Slug() Rule {
+	return patternRule("slug", slugPattern)
+}
+
+// JSON checks that the field is a string containing valid json.
+func JSON() Rule {
+	return formatRule(Descriptor{Name: "json"}, "json", func(value string) bool {
+		return json.Valid([]byte(value))
+	})
+}
+
+func patternRule(key string, pattern string) Rule {
+	regex := regexp.MustCompile(pattern)
+
+	return formatRule(Descriptor{Name: key, Params: map[string]any{"pattern": pattern}}, key, regex.MatchString)
+}
+
+// formatRule checks string fields with the function. Absent and null fields and empty strings pass, like in
+// CompiledRegex, while values of other types fail.
+func formatRule(descriptor Descriptor, key string, valid func(value string) bool) Rule {
+	return described(descriptor, func(data map[string]any, field string) (*messages.Message, error) {
+		value, exists := data[field]
+		if !exists || value == nil {
+			return nil, nil
+		}
+
+		text, ok := value.(string)
+		if !ok {
+			return messages.New(key, field, descriptor.Params), nil
+		}
+
+		if len(text) > 0 && !valid(text) {
+			return messages.New(key, field, descriptor.Params), nil
+		}
+
+		return nil, nil
+	})
+}
+
+func isUUID(value string, version int) bool {
+	if len(value) != 36 {
+		return false
+	}
+
+	for i := 0; i < len(value); i++ {
+		character := value[i]
+		if i == 8 || i == 13 || i == 18 || i == 23 {
+			if character != '-' {
+				return false
+			}
+
+			continue
+		}
+
+		if !isHexDigit(character) {
+			return false
+		}
+	}
+
+	if version == 0 {
+		return true
+	}
+
+	variant := value[19] | 0x20
+	return int(value[14]-'0') == version && (variant == '8' || variant == '9' || variant == 'a' || variant == 'b')
+}
+
+func isHexDigit(character byte) bool {
+	return '0' <= character && character <= '9' || 'a' <= character && character <= 'f' || 'A' <= character && character <= 'F'
+}
@@ -0,0 +1,61 @@
+package rules
+
+import "testing"
+
+func TestFormats(t *testing.T) {
+	tableTests := []struct {
+		name     string
+		ruleFunc Rule
+		data     map[string]any
+		want     string
+	}{
+		{"Missing UUID", UUID(4), map[string]any{}, noError},
+		{"UUID of any version", UUID(0), map[string]any{"test": "00000000-0000-0000-0000-000000000000"}, noError},
+		{"UUID v4", UUID(4), map[string]any{"test": "9B2C6F3E-1D4A-4E7B-8C2D-3F4A5B6C7D8E"}, noError},
+		{"UUID of another version", UUID(4), map[string]any{"test": "9b2c6f3e-1d4a-1e7b-8c2d-3f4a5b6c7d8e"}, "The test field must be a valid UUID"},
+		{"UUID with an invalid variant", UUID(4), map[string]any{"test": "9b2c6f3e-1d4a-4e7b-cc2d-3f4a5b6c7d8e"}, "The test field must be a valid UUID"},
+		{"UUID without hyphens", UUID(0), map[string]any{"test": "9b2c6f3e1d4a4e7b8c2d3f4a5b6c7d8e"}, "The test field must be a valid UUID"},
+		{"UUID with a non-hex digit", UUID(0), map[string]any{"test": "9b2c6f3e-1d4a-4e7b-8c2d-3f4a5b6c7d8g"}, "The test field must be a valid UUID"},
+		{"Non-string UUID", UUID(0), map[string]any{"test": 42}, "The test field must be a valid UUID"},
+		{"Empty URL", URL(), map[string]any{"test": ""}, noError},
+		{"Null URL", URL(), map[string]any{"test": nil}, noError},
+		{"HTTPS URL", URL(), map[string]any{"test": "https://example.com/path?query=1"}, noError},
+		{"URL with an upper case scheme", URL(), map[string]any{"test": "HTTP://example.com"}, noError},
+		{"URL with another scheme", URL(), map[string]any{"test": "ftp://example.com"}, "The test field must be a valid URL"},
+		{"URL with an allowed scheme", URL("ftp"), map[string]any{"test": "ftp://example.com"}, noError},
+		{"Relative URL", URL(), map[string]any{"test": "/path"}, "The test field must be a valid URL"},
+		{"IPv4", IP(), map[string]any{"test": "192.168.0.1"}, noError},
+		{"IPv6", IP(), map[string]any{"test": "2001:db8::1"}, noError},
+		{"Invalid IP", IP(), map[string]any{"test": "256.0.0.1"}, "The test field must be a valid IP address"},
+		{"IPv4 network", CIDR(), map[string]any{"test": "10.0.0.0/8"}, noError},
+		{"IPv6 network", CIDR(), map[string]any{"test": "2001:db8::/32"}, noError},
+		{"Network without a prefix", CIDR(), map[string]any{"test": "10.0.0.0"}, "The test field must be a valid network in the CIDR notation"},
+		{"E.164 phone", Phone(), map[string]any{"test": "+79991234567"}, noError},
+		{"Phone without a plus", Phone(), map[string]any{"test": "79991234567"}, "The test field must be a valid phone number in the international format"},
+		{"Phone with spaces", Phone(), map[string]any{"test": "+7 999 123 45 67"}, "The test field must be a valid phone number in the international format"},
+		{"Too long phone", Phone(), map[string]any{"test": "+1234567890123456"}, "The test field must be a valid phone number in the international format"},
+		{"IANA timezone", Timezone(), map[string]any{"test": "Europe/Moscow"}, noError},
+		{"UTC timezone", Timezone(), map[string]any{"test": "UTC"}, noError},
+		{"Local timezone", Timezone(), map[string]any{"test": "Local"}, "The test field must be a valid timezone"},
+		{"Unknown timezone", Timezone(), map[string]any{"test": "Mars/Olympus"}, "The test field must be a valid timezone"},
+		{"Short hex color", HexColor(), map[string]any{"test": "#fA0"}, noError},
+		{"Hex color with alpha", HexColor(), map[string]any{"test": "#ff000080"}, noError},
+		{"Hex color without a hash", HexColor(), map[string]any{"test": "ff0000"}, "The test field must be a valid hexadecimal color"},
+		{"Hex color of four digits", HexColor(), map[string]any{"test": "#ff00"}, noError},
+		{"Hex color of five digits", HexColor(), map[string]any{"test": "#ff000"}, "The test field must be a valid hexadecimal color"},
+		{"Slug", Slug(), map[string]any{"test": "my-first-post-2"}, noError},
+		{"Slug with upper case letters", Slug(), map[string]any{"test": "My-Post"}, "The test field must only contain lower case letters, numbers and dashes"},
+		{"Slug with double dashes", Slug(), map[string]any{"test": "my--post"}, "The test field must only contain lower case letters, numbers and dashes"},
+		{"Slug with a trailing dash", Slug(), map[string]any{"test": "post-"}, "The test field must only contain lower case letters, numbers and dashes"},
+		{"JSON object", JSON(), map[string]any{"test": `{"a": [1, 2]}`}, noError},
+		{"Invalid JSON", JSON(), map[string]any{"test": `{"a": }`}, "The test field must be a valid JSON string"},
+	}
+
+	for _, tt := range tableTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, _ := tt.ruleFunc.Check(tt.data, "test"); got.String() != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,279 @@
+package rules
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/EugeneNail/motivatr-lib-common/pkg/validation/messages"
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Number is a limit of Max, Min, Between and MultipleOf. Limits are compared with the values exactly,
+// so Max(uint64(math.MaxUint64)) and Max(json.Number("0.1")) behave as written.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 |
+		~float32 | ~float64 |
+		json.Number | *big.Int | *big.Float | *big.Rat
+}
+
+// bound is a limit prepared for comparisons. Lengths are compared with floor, which keeps the rules
+// measuring strings and arrays free of allocations.
+type bound struct {
+	value    *big.Rat
+	floor    int64
+	integral bool
+}
+
+// newBound panics if the limit is not a finite number, e.g. json.Number("ten") or math.NaN().
+func newBound[N Number](limit N) bound {
+	value, ok := toRat(limit)
+	if !ok {
+		panic(fmt.Sprintf("the limit %v is not a finite number", limit))
+	}
+
+	floor := new(big.Int).Div(value.Num(), value.Denom())
+	result := bound{value: value, integral: value.IsInt()}
+
+	switch {
+	case !floor.IsInt64() && floor.Sign() > 0:
+		result.floor = math.MaxInt64
+	case !floor.IsInt64():
+		result.floor = math.MinInt64
+	default:
+		result.floor = floor.Int64()
+	}
+
+	return result
+}
+
+// compareLength returns -1, 0 or 1 as the length is less than, equal to or greater than the bound.
+func (bound bound) compareLength(length int) int {
+	switch {
+	case int64(length) > bound.floor:
+		return 1
+	case int64(length) == bound.floor && bound.integral:
+		return 0
+	}
+
+	return -1
+}
+
+// measure compares the value with the bound the way Max and Min do: numbers by value, strings by the number
+// of characters and arrays and maps by the number of items.
+func measure(value any, bound bound) (comparison int, kind string, ok bool) {
+	if text, isString := value.(string); isString {
+		return bound.compareLength(utf8.RuneCountInString(text)), "string", true
+	}
+
+	if comparison, isNumber := compareNumber(value, bound); isNumber {
+		return comparison, "numeric", true
+	}
+
+	reflected := reflect.ValueOf(value)
+	switch reflected.Kind() {
+	case reflect.Slice, reflect.Map, reflect.Array:
+		return bound.compareLength(reflected.Len()), "array", true
+	}
+
+	return 0, "", false
+}
+
+func compareNumber(value any, bound bound) (int, bool) {
+	reflected := reflect.ValueOf(value)
+	if bound.integral && bound.floor != math.MaxInt64 && bound.floor != math.MinInt64 {
+		switch reflected.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return compareInt64(reflected.Int(), bound.floor), true
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			if bound.floor < 0 || reflected.Uint() > math.MaxInt64 {
+				return 1, true
+			}
+
+			return compareInt64(int64(reflected.Uint()), bound.floor), true
+		}
+	}
+
+	number, ok := toRat(value)
+	if !ok {
+		return 0, false
+	}
+
+	return number.Cmp(bound.value), true
+}
+
+func compareInt64(a int64, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+
+	return 0
+}
+
+// toRat converts a number to an exact fraction. Floats are converted from their shortest decimal representation,
+// so that 0.1 equals json.Number("0.1"). Infinities and NaN are not numbers.
+func toRat(value any) (*big.Rat, bool) {
+	switch typedValue := value.(type) {
+	case json.Number:
+		return parseDecimal(string(typedValue))
+	case *big.Int:
+		if typedValue == nil {
+			return nil, false
+		}
+
+		return new(big.Rat).SetInt(typedValue), true
+	case *big.Float:
+		if typedValue == nil || typedValue.IsInf() {
+			return nil, false
+		}
+
+		rat, _ := typedValue.Rat(nil)
+		return rat, true
+	case *big.Rat:
+		if typedValue == nil {
+			return nil, false
+		}
+
+		return new(big.Rat).Set(typedValue), true
+	}
+
+	reflected := reflect.ValueOf(value)
+	switch reflected.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return new(big.Rat).SetInt64(reflected.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return new(big.Rat).SetUint64(reflected.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		float := reflected.Float()
+		if math.IsInf(float, 0) || math.IsNaN(float) {
+			return nil, false
+		}
+
+		return new(big.Rat).SetString(strconv.FormatFloat(float, 'g', -1, reflected.Type().Bits()))
+	}
+
+	return nil, false
+}
+
+const (
+	maxNumberLength   = 1000 // characters of the digits of a parsed number, without its exponent
+	maxNumberExponent = 1000 // the magnitude of the decimal exponent of a parsed number
+)
+
+// parseDecimal parses the decimal text of a number from a request, rejecting numbers whose exact value would be
+// expensive to compute, e.g. "1e1000000".
+func parseDecimal(text string) (*big.Rat, bool) {
+	text = strings.ToLower(text)
+	if strings.ContainsAny(text, "xpob_/") {
+		return nil, false
+	}
+
+	digits, exponent, hasExponent := strings.Cut(text, "e")
+	if len(digits) > maxNumberLength {
+		return nil, false
+	}
+
+	if hasExponent {
+		power, err := strconv.Atoi(exponent)
+		if err != nil || power > maxNumberExponent || power < -maxNumberExponent {
+			return nil, false
+		}
+	}
+
+	return new(big.Rat).SetString(text)
+}
+
+// displayNumber prepares a limit for messages, rendering fractions as decimals where possible.
+func displayNumber(limit any) any {
+	rat, isRat := limit.(*big.Rat)
+	if !isRat || rat == nil {
+		return limit
+	}
+
+	if rat.IsInt() {
+		return rat.Num().String()
+	}
+
+	if places, exact := rat.FloatPrec(); exact {
+		return rat.FloatString(places)
+	}
+
+	return rat.RatString()
+}
+
+// Integer checks that the field is a number without a fractional part, e.g. 42, 42.0 or json.Number("42").
+// Numeric strings are not numbers, convert them with sanitizers.Number first.
+func Integer() Rule {
+	return numberRule(Descriptor{Name: "integer"}, "integer", nil, func(number *big.Rat) bool {
+		return number.IsInt()
+	})
+}
+
+// Numeric checks that the field is a number of any type, including json.Number and the big numbers.
+func Numeric() Rule {
+	return numberRule(Descriptor{Name: "numeric"}, "numeric", nil, func(number *big.Rat) bool {
+		return true
+	})
+}
+
+// Decimal checks that the field is a number with at most the given number of decimal places, e.g. Decimal(2) for prices.
+func Decimal(places int) Rule {
+	params := map[string]any{"places": places}
+	scale := new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(places)), nil))
+
+	return numberRule(Descriptor{Name: "decimal", Params: params}, "decimal", params, func(number *big.Rat) bool {
+		return new(big.Rat).Mul(number, scale).IsInt()
+	})
+}
+
+// MultipleOf checks that the field is a number divisible by the step, e.g. MultipleOf(0.25). It panics if the step is zero.
+func MultipleOf[N Number](step N) Rule {
+	stepBound := newBound(step)
+	if stepBound.value.Sign() == 0 {
+		panic("the step of the multiple_of rule must not be zero")
+	}
+
+	params := map[string]any{"value": displayNumber(step)}
+
+	return numberRule(Descriptor{Name: "multiple_of", Params: map[string]any{"value": step}}, "multiple_of", params, func(number *big.Rat) bool {
+		return new(big.Rat).Quo(number, stepBound.value).IsInt()
+	})
+}
+
+// Positive checks that the field is a number greater than zero.
+func Positive() Rule {
+	return numberRule(Descriptor{Name: "positive"}, "positive", nil, func(number *big.Rat) bool {
+		return number.Sign() > 0
+	})
+}
+
+// NonNegative checks that the field is a number greater than or equal to zero.
+func NonNegative() Rule {
+	return numberRule(Descriptor{Name: "non_negative"}, "non_negative", nil, func(number *big.Rat) bool {
+		return number.Sign() >= 0
+	})
+}
+
+// numberRule checks numeric fields with the function. Absent and null fields pass, while values of other types fail.
+func numberRule(descriptor Descriptor, key string, params map[string]any, valid func(number *big.Rat) bool) Rule {
+	return described(descriptor, func(data map[string]any, field string) (*messages.Message, error) {
+		value, exists := data[field]
+		if !exists || value == nil {
+			return nil, nil
+		}
+
+		number, ok := toRat(value)
+		if !ok || !valid(number) {
+			return messages.New(key, field, params), nil
+		}
+
+		return nil, nil
+	})
+}
@@ -0,0 +1,94 @@
+package rules
+
+import (
+	"encoding/json"
+	"math"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+func TestExactLimits(t *testing.T) {
+	hugeLimit, _ := new(big.Int).SetString("100000000000000000000", 10)
+	tableTests := []struct {
+		name     string
+		ruleFunc Rule
+		data     map[string]any
+		want     string
+	}{
+		{"Float below a float limit", Max(0.5), map[string]any{"test": 0.25}, noError},
+		{"Float above a float limit", Max(0.5), map[string]any{"test": 0.51}, "The test field must not be greater than 0.5"},
+		{"Integer above a float limit", Max(0.5), map[string]any{"test": 1}, "The test field must not be greater than 0.5"},
+		{"Float equal to a json number", Max(json.Number("0.1")), map[string]any{"test": 0.1}, noError},
+		{"Float32 equal to a float limit", Max(0.1), map[string]any{"test": float32(0.1)}, noError},
+		{"Json number above a json number", Max(json.Number("0.1")), map[string]any{"test": json.Number("0.10000000000000001")}, "The test field must not be greater than 0.1"},
+		{"Large unsigned value", Max(10), map[string]any{"test": uint64(math.MaxUint64)}, "The test field must not be greater than 10"},
+		{"Large unsigned value below the limit", Max(uint64(math.MaxUint64)), map[string]any{"test": uint64(math.MaxUint64 - 1)}, noError},
+		{"Large unsigned value above a negative limit", Min(-1), map[string]any{"test": uint64(math.MaxUint64)}, noError},
+		{"Big integer below a big limit", Max(hugeLimit), map[string]any{"test": uint64(math.MaxUint64)}, noError},
+		{"Big integer above a big limit", Max(hugeLimit), map[string]any{"test": new(big.Int).Add(hugeLimit, big.NewInt(1))}, "The test field must not be greater than 100000000000000000000"},
+		{"Fraction limit", Min(big.NewRat(1, 4)), map[string]any{"test": 0.2}, "The test field must not be less than 0.25"},
+		{"String below a fractional limit", Min(2.5), map[string]any{"test": "ab"}, "The test field must not be less than 2.5 characters"},
+		{"String above a fractional limit", Min(2.5), map[string]any{"test": "abc"}, noError},
+		{"Array within fractional limits", Between(0.5, 2.5), map[string]any{"test": []int{1, 2}}, noError},
+		{"Number outside fractional limits", Between(0.5, 2.5), map[string]any{"test": 2.75}, "The test field must be between 0.5 and 2.5"},
+	}
+
+	for _, tt := range tableTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, _ := tt.ruleFunc.Check(tt.data, "test"); got.String() != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInvalidLimitPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected a panic")
+		}
+	}()
+
+	Max(json.Number("ten"))
+}
+
+func TestNumericRules(t *testing.T) {
+	tableTests := []struct {
+		name     string
+		ruleFunc Rule
+		data     map[string]any
+		want     string
+	}{
+		{"Missing integer", Integer(), map[string]any{}, noError},
+		{"Null integer", Integer(), map[string]any{"test": nil}, noError},
+		{"Integer", Integer(), map[string]any{"test": int64(42)}, noError},
+		{"Integral float", Integer(), map[string]any{"test": 42.0}, noError},
+		{"Fractional float", Integer(), map[string]any{"test": 4.2}, "The test field must be an integer"},
+		{"Numeric string", Integer(), map[string]any{"test": "42"}, "The test field must be an integer"},
+		{"Json number", Numeric(), map[string]any{"test": json.Number("4.2e1")}, noError},
+		{"Big float", Numeric(), map[string]any{"test": big.NewFloat(4.2)}, noError},
+		{"Infinity", Numeric(), map[string]any{"test": math.Inf(1)}, "The test field must be a number"},
+		{"Boolean", Numeric(), map[string]any{"test": true}, "The test field must be a number"},
+		{"Json number with a huge exponent", Numeric(), map[string]any{"test": json.Number("1e1000000")}, "The test field must be a number"},
+		{"Json number with too many digits", Numeric(), map[string]any{"test": json.Number(strings.Repeat("9", 1001))}, "The test field must be a number"},
+		{"Price", Decimal(2), map[string]any{"test": 19.99}, noError},
+		{"Price of an integer", Decimal(2), map[string]any{"test": 20}, noError},
+		{"Price with too many places", Decimal(2), map[string]any{"test": json.Number("19.999")}, "The test field must be a number with at most 2 decimal places"},
+		{"Multiple of a fraction", MultipleOf(0.25), map[string]any{"test": 1.75}, noError},
+		{"Not a multiple of a fraction", MultipleOf(0.25), map[string]any{"test": 1.7}, "The test field must be a multiple of 0.25"},
+		{"Multiple of an integer", MultipleOf(5), map[string]any{"test": -15}, noError},
+		{"Positive number", Positive(), map[string]any{"test": 0.01}, noError},
+		{"Zero", Positive(), map[string]any{"test": 0}, "The test field must be greater than 0"},
+		{"Non-negative zero", NonNegative(), map[string]any{"test": uint8(0)}, noError},
+		{"Negative number", NonNegative(), map[string]any{"test": -0.01}, "The test field must not be negative"},
+	}
+
+	for _, tt := range tableTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, _ := tt.ruleFunc.Check(tt.data, "test"); got.String() != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package rules
+
+import (
+	"github.com/EugeneNail/motivatr-lib-common/pkg/validation/messages"
+	"strings"
+)
+
+// GeneralField is the field of the errors that concern the whole object rather than one of its fields.
+const GeneralField = "_"
+
+// ObjectRuleFunc checks several fields at once and returns a message for every broken invariant.
+// Messages without a field are reported under GeneralField.
+type ObjectRuleFunc func(data map[string]any) (failures []*messages.Message, err error)
+
+// ObjectRule is an invariant of several fields, e.g. "at least one of email and phone". The validator runs object
+// rules after the field rules and skips the ones whose fields already have errors, so the check can rely on them.
+type ObjectRule struct {
+	Fields []string
+	Check  ObjectRuleFunc
+}
+
+// AtLeastOneOf requires at least one of the fields to be present and not empty. The error is reported under GeneralField.
+func AtLeastOneOf(fields ...string) ObjectRule {
+	params := map[string]any{"values": strings.Join(fields, ", ")}
+
+	return ObjectRule{Fields: fields, Check: func(data map[string]any) ([]*messages.Message, error) {
+		for _, field := range fields {
+			if value, exists := data[field]; exists && !isEmpty(value) {
+				return nil, nil
+			}
+		}
+
+		return []*messages.Message{messages.New("at_least_one_of", GeneralField, params)}, nil
+	}}
+}
+
+// OnlyOneOf forbids more than one of the fields to be present and not empty. The error is reported under every
+// field but the first one given.
+func OnlyOneOf(fields ...string) ObjectRule {
+	return ObjectRule{Fields: fields, Check: func(data map[string]any) ([]*messages.Message, error) {
+		var failures []*messages.Message
+
+		first := ""
+		for _, field := range fields {
+			if value, exists := data[field]; !exists || isEmpty(value) {
+				continue
+			}
+
+			if len(first) == 0 {
+				first = field
+				continue
+			}
+
+			failures = append(failures, messages.New("only_one_of", field, map[string]any{"other": messages.Field(first)}))
+		}
+
+		return failures, nil
+	}}
+}
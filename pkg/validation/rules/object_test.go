@@ -0,0 +1,36 @@
+package rules
+
+import "testing"
+
+func TestObjectRules(t *testing.T) {
+	tableTests := []struct {
+		name       string
+		objectRule ObjectRule
+		data       map[string]any
+		want       map[string]string
+	}{
+		{"One of the fields", AtLeastOneOf("email", "phone"), map[string]any{"phone": "+79991234567"}, map[string]string{}},
+		{"Empty fields", AtLeastOneOf("email", "phone"), map[string]any{"email": ""}, map[string]string{"_": "At least one of the email, phone fields is required"}},
+		{"Only one field", OnlyOneOf("email", "phone"), map[string]any{"email": "merlin@camelot.uk", "phone": ""}, map[string]string{}},
+		{"Several fields", OnlyOneOf("email", "phone", "telegram"), map[string]any{"email": "merlin@camelot.uk", "telegram": "@merlin"}, map[string]string{"telegram": "The telegram field is prohibited when email is present"}},
+	}
+
+	for _, tt := range tableTests {
+		t.Run(tt.name, func(t *testing.T) {
+			failures, err := tt.objectRule.Check(tt.data)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(failures) != len(tt.want) {
+				t.Fatalf("got %v, want %v", failures, tt.want)
+			}
+
+			for _, failure := range failures {
+				if got := failure.String(); got != tt.want[failure.Field] {
+					t.Errorf("%s: got %q, want %q", failure.Field, got, tt.want[failure.Field])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,289 @@
+package rules
+
+import (
+	"bufio"
+	"fmt"
+	"github.com/EugeneNail/motivatr-lib-common/pkg/validation/messages"
+	"math"
+	"os"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// PasswordPolicy describes the requirements checked by PasswordWith. Zero values disable the checks, e.g. a policy
+// for legacy imports may only limit the length, while a policy for admin accounts may be stricter than the default:
+//
+//	banned, err := rules.LoadBannedPasswords("storage/banned_passwords.txt")
+//	...
+//	admin := rules.DefaultPasswordPolicy
+//	admin.MinLength = 12
+//	admin.Symbols = true
+//	admin.Banned = banned
+//	admin.DistinctFrom = []string{"email", "name"}
+//	admin.MinEntropy = 80
+type PasswordPolicy struct {
+	MinLength    int
+	MaxLength    int
+	Lowercase    bool            // requires a lower case letter
+	Uppercase    bool            // requires an upper case letter
+	Numbers      bool            // requires a number
+	Symbols      bool            // requires a punctuation mark or a symbol, e.g. "!" or "$"
+	Banned       map[string]bool // lower case passwords that are rejected, see LoadBannedPasswords
+	DistinctFrom []string        // fields whose values must not appear in the password, e.g. the email or the name
+	MinEntropy   float64         // the minimal PasswordEntropy in bits
+}
+
+// DefaultPasswordPolicy is the policy of Password.
+var DefaultPasswordPolicy = PasswordPolicy{MinLength: 8, Lowercase: true, Uppercase: true, Numbers: true}
+
+// Password checks that the field satisfies DefaultPasswordPolicy, see PasswordWith.
+func Password() Rule {
+	return PasswordWith(DefaultPasswordPolicy)
+}
+
+// PasswordWith checks that the field satisfies the policy. Every unmet requirement is reported as a child of
+// a message that reads like the first one, so a validator with CollectAll keeps all of them, e.g. both
+// the length and the upper case letter of "secret", while other validators keep the first one.
+// Values other than strings, e.g. numbers or arrays, are not passwords and fail with a single message.
+func PasswordWith(policy PasswordPolicy) Rule {
+	params := map[string]any{"min_length": policy.MinLength}
+	if policy.MaxLength > 0 {
+		params["max_length"] = policy.MaxLength
+	}
+
+	ruleFuncs := policy.checks()
+
+	return described(Descriptor{Name: "password", Params: params}, func(data map[string]any, field string) (*messages.Message, error) {
+		if message := notPassword(data, field); message != nil {
+			return message, nil
+		}
+
+		var failures []*messages.Message
+		for _, ruleFunc := range ruleFuncs {
+			message, err := ruleFunc.Check(data, field)
+			if err != nil {
+				return nil, err
+			}
+
+			if message != nil {
+				failures = append(failures, message)
+			}
+		}
+
+		switch len(failures) {
+		case 0:
+			return nil, nil
+		case 1:
+			return failures[0], nil
+		}
+
+		first := failures[0]
+		return &messages.Message{Key: first.Key, Field: field, Params: first.Params, Children: failures}, nil
+	})
+}
+
+// PasswordRules returns the checks of the Password rule as separate rules, e.g. to mix them with other rules
+// of the field or to replace the message of one of them.
+func PasswordRules() []Rule {
+	return DefaultPasswordPolicy.Rules()
+}
+
+// Rules returns the checks of the policy as separate rules, like PasswordRules does for the default policy.
+// The first rule rejects values other than strings.
+func (policy PasswordPolicy) Rules() []Rule {
+	passwordString := RuleFunc(func(data map[string]any, field string) (*messages.Message, error) {
+		return notPassword(data, field), nil
+	})
+
+	return append([]Rule{passwordString}, policy.checks()...)
+}
+
+func (policy PasswordPolicy) checks() []Rule {
+	var ruleFuncs []Rule
+	if policy.MinLength > 0 {
+		ruleFuncs = append(ruleFuncs, Min(policy.MinLength))
+	}
+
+	if policy.MaxLength > 0 {
+		ruleFuncs = append(ruleFuncs, Max(policy.MaxLength))
+	}
+
+	if policy.Lowercase {
+		ruleFuncs = append(ruleFuncs, passwordClass(unicode.IsLower, "password.lowercase"))
+	}
+
+	if policy.Uppercase {
+		ruleFuncs = append(ruleFuncs, passwordClass(unicode.IsUpper, "password.uppercase"))
+	}
+
+	if policy.Numbers {
+		ruleFuncs = append(ruleFuncs, passwordClass(unicode.IsDigit, "password.number"))
+	}
+
+	if policy.Symbols {
+		ruleFuncs = append(ruleFuncs, passwordClass(isSymbol, "password.symbol"))
+	}
+
+	if len(policy.Banned) > 0 {
+		ruleFuncs = append(ruleFuncs, passwordCheck(func(data map[string]any, field string, password string) *messages.Message {
+			if policy.Banned[strings.ToLower(password)] {
+				return messages.New("password.banned", field, nil)
+			}
+
+			return nil
+		}))
+	}
+
+	if len(policy.DistinctFrom) > 0 {
+		ruleFuncs = append(ruleFuncs, passwordCheck(func(data map[string]any, field string, password string) *messages.Message {
+			password = strings.ToLower(password)
+			for _, other := range policy.DistinctFrom {
+				for _, part := range personalParts(data[other]) {
+					if strings.Contains(password, part) {
+						return messages.New("password.personal", field, map[string]any{"other": messages.Field(other)})
+					}
+				}
+			}
+
+			return nil
+		}))
+	}
+
+	if policy.MinEntropy > 0 {
+		ruleFuncs = append(ruleFuncs, passwordCheck(func(data map[string]any, field string, password string) *messages.Message {
+			if PasswordEntropy(password) < policy.MinEntropy {
+				return messages.New("password.entropy", field, nil)
+			}
+
+			return nil
+		}))
+	}
+
+	return ruleFuncs
+}
+
+// notPassword returns the password message for present values that are not strings. Absent fields and null pass,
+// like in CompiledRegex.
+func notPassword(data map[string]any, field string) *messages.Message {
+	value, exists := data[field]
+	if !exists || value == nil {
+		return nil
+	}
+
+	if _, isString := value.(string); !isString {
+		return messages.New("password", field, nil)
+	}
+
+	return nil
+}
+
+// passwordCheck runs the check on string fields. Absent fields, empty strings and values of other types pass,
+// leaving them to Required, Min and notPassword.
+func passwordCheck(check func(data map[string]any, field string, password string) *messages.Message) RuleFunc {
+	return func(data map[string]any, field string) (*messages.Message, error) {
+		password, ok := data[field].(string)
+		if !ok || len(password) == 0 {
+			return nil, nil
+		}
+
+		return check(data, field, password), nil
+	}
+}
+
+func passwordClass(isClass func(character rune) bool, key string) Rule {
+	return passwordCheck(func(data map[string]any, field string, password string) *messages.Message {
+		if strings.IndexFunc(password, isClass) < 0 {
+			return messages.New(key, field, nil)
+		}
+
+		return nil
+	})
+}
+
+func isSymbol(character rune) bool {
+	return unicode.IsPunct(character) || unicode.IsSymbol(character)
+}
+
+// personalParts splits the value into lower case words of at least 3 letters, ignoring the domain of emails,
+// e.g. "Merlin.Ambrosius@camelot.uk" yields "merlin" and "ambrosius".
+func personalParts(value any) []string {
+	text, ok := value.(string)
+	if !ok {
+		return nil
+	}
+
+	text, _, _ = strings.Cut(strings.ToLower(text), "@")
+
+	var parts []string
+	for _, part := range strings.FieldsFunc(text, func(character rune) bool {
+		return !unicode.IsLetter(character) && !unicode.IsDigit(character)
+	}) {
+		if utf8.RuneCountInString(part) >= 3 {
+			parts = append(parts, part)
+		}
+	}
+
+	return parts
+}
+
+// PasswordEntropy estimates the strength of the password in bits as its length multiplied by the logarithm
+// of the size of the character pool, which grows with every class of characters used.
+func PasswordEntropy(password string) float64 {
+	var lower, upper, digit, symbol, other bool
+	for _, character := range password {
+		switch {
+		case 'a' <= character && character <= 'z':
+			lower = true
+		case 'A' <= character && character <= 'Z':
+			upper = true
+		case '0' <= character && character <= '9':
+			digit = true
+		case character < utf8.RuneSelf:
+			symbol = true
+		default:
+			other = true
+		}
+	}
+
+	pool := 0
+	for _, class := range []struct {
+		used bool
+		size int
+	}{{lower, 26}, {upper, 26}, {digit, 10}, {symbol, 33}, {other, 64}} {
+		if class.used {
+			pool += class.size
+		}
+	}
+
+	if pool == 0 {
+		return 0
+	}
+
+	return float64(utf8.RuneCountInString(password)) * math.Log2(float64(pool))
+}
+
+// LoadBannedPasswords reads a list of banned passwords for PasswordPolicy.Banned, one per line.
+// Empty lines and lines starting with # are skipped.
+func LoadBannedPasswords(path string) (map[string]bool, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening the banned passwords file: %w", err)
+	}
+	defer file.Close()
+
+	banned := make(map[string]bool)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if len(line) > 0 && !strings.HasPrefix(line, "#") {
+			banned[strings.ToLower(line)] = true
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading the banned passwords file: %w", err)
+	}
+
+	return banned, nil
+}
@@ -0,0 +1,100 @@
+package rules
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestPasswordWith(t *testing.T) {
+	admin := PasswordPolicy{
+		MinLength:    12,
+		MaxLength:    64,
+		Lowercase:    true,
+		Uppercase:    true,
+		Numbers:      true,
+		Symbols:      true,
+		Banned:       map[string]bool{"excalibur42!camelot": true},
+		DistinctFrom: []string{"email", "name"},
+		MinEntropy:   80,
+	}
+	legacy := PasswordPolicy{MinLength: 4}
+	data := func(password string) map[string]any {
+		return map[string]any{"test": password, "email": "Merlin.Ambrosius@camelot.uk", "name": "Мерлин"}
+	}
+
+	tableTests := []struct {
+		name     string
+		ruleFunc Rule
+		data     map[string]any
+		want     string
+	}{
+		{"Default policy", Password(), data("Excalibur42"), noError},
+		{"Default policy with a Cyrillic password", Password(), data("экскалибур42"), "The test field must contain at least one upper case letter"},
+		{"Admin policy", PasswordWith(admin), data("Excalibur42!Avalon"), noError},
+		{"Too short", PasswordWith(admin), data("Ex42!"), "The test field must not be less than 12 characters"},
+		{"Too long", PasswordWith(admin), data("Excalibur42!" + strings.Repeat("Avalon", 10)), "The test field must not be greater than 64 characters"},
+		{"Without symbols", PasswordWith(admin), data("Excalibur42Avalon"), "The test field must contain at least one symbol"},
+		{"Banned password", PasswordWith(admin), data("EXCALIBUR42!camelot"), "The test field must not be a commonly used password"},
+		{"Password with the email", PasswordWith(admin), data("Ambrosius42!Avalon"), "The test field must not contain the email"},
+		{"Password with the domain of the email", PasswordWith(admin), data("Camelot42!Avalon"), noError},
+		{"Password with the name", PasswordWith(admin), data("Avalon42!мерлин"), "The test field must not contain the name"},
+		{"Low entropy", PasswordWith(admin), data("Aaaaaaaaaa1!"), "The test field is too easy to guess"},
+		{"Several unmet requirements", Password(), data("secret"), "The test field must not be less than 8 characters"},
+		{"Integer instead of a password", Password(), map[string]any{"test": int64(123456789)}, "The test field must be a valid password"},
+		{"Array instead of a password", Password(), map[string]any{"test": []any{"E", "x", "c", "a", "l", "i", "b", "u", "r"}}, "The test field must be a valid password"},
+		{"Integer instead of a password of a policy", PasswordWith(legacy), map[string]any{"test": 12345}, "The test field must be a valid password"},
+		{"Null password", Password(), map[string]any{"test": nil}, noError},
+		{"Legacy policy", PasswordWith(legacy), data("abcd"), noError},
+		{"Legacy policy with a short password", PasswordWith(legacy), data("abc"), "The test field must not be less than 4 characters"},
+	}
+
+	for _, tt := range tableTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, _ := tt.ruleFunc.Check(tt.data, "test"); got.String() != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPasswordEntropy(t *testing.T) {
+	tableTests := []struct {
+		name     string
+		password string
+		want     float64
+	}{
+		{"Empty", "", 0},
+		{"Numbers", "1234", 4 * 3.321928094887362},
+		{"Lower case letters and numbers", "abcd1234", 8 * 5.169925001442312},
+	}
+
+	for _, tt := range tableTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PasswordEntropy(tt.password); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadBannedPasswords(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "banned.txt")
+	if err := os.WriteFile(path, []byte("# common passwords\nPassword1\n\n  qwerty123  \n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	banned, err := LoadBannedPasswords(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(banned) != 2 || !banned["password1"] || !banned["qwerty123"] {
+		t.Errorf("got %v, want password1 and qwerty123", banned)
+	}
+
+	if _, err := LoadBannedPasswords(filepath.Join(t.TempDir(), "missing.txt")); err == nil {
+		t.Errorf("expected an error")
+	}
+}
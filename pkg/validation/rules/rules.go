@@ -1,12 +1,13 @@
 package rules
 
 import (
+	"encoding/json"
 	"fmt"
+	"github.com/EugeneNail/motivatr-lib-common/pkg/validation/messages"
 	"math"
 	"reflect"
 	"regexp"
 	"time"
-	"unicode/utf8"
 )
 
 const (
@@ -17,208 +18,125 @@ const (
 	Email    = `^[\w.+-]+@[\w.+-]+\.[a-zA-Z]{1,10}$`
 )
 
-type RuleFunc func(data map[string]any, field string) (message string, err error)
+// Rule validates a field of the data, returning nil when the field passes. Built-in rules are Described,
+// custom rules are usually a RuleFunc.
+type Rule interface {
+	Check(data map[string]any, field string) (message *messages.Message, err error)
+}
 
-func Required() RuleFunc {
-	return func(data map[string]any, field string) (string, error) {
-		message := fmt.Sprintf("The %s field is required", field)
+type RuleFunc func(data map[string]any, field string) (message *messages.Message, err error)
 
-		value, exists := data[field]
-		if !exists {
-			return message, nil
-		}
+func (ruleFunc RuleFunc) Check(data map[string]any, field string) (*messages.Message, error) {
+	return ruleFunc(data, field)
+}
 
-		switch typedValue := value.(type) {
-		case string:
-			if len(typedValue) == 0 {
-				return message, nil
-			}
-		case int, uint, int8, uint8, int16, uint16, int32, uint32, int64, uint64:
-			if typedValue == 0 {
-				return message, nil
-			}
-		case float32:
-			delta := 1e-6
-			if math.Abs(float64(typedValue)) < delta {
-				return message, nil
-			}
-
-		case float64:
-			delta := 1e-6
-			if math.Abs(typedValue) < delta {
-				return message, nil
-			}
-		}
+// TextRuleFunc is a rule returning the text of its failure, or an empty string when the field passes,
+// like the rules written before RuleFunc returned messages.
+type TextRuleFunc func(data map[string]any, field string) (message string, err error)
 
-		reflected := reflect.ValueOf(value)
-		kind := reflected.Kind()
-		if (kind == reflect.Slice || kind == reflect.Map || kind == reflect.Array) && reflected.Len() == 0 {
-			return message, nil
+// FromText adapts a TextRuleFunc to a RuleFunc. Its failures are shown as they are, without translation.
+func FromText(ruleFunc TextRuleFunc) RuleFunc {
+	return func(data map[string]any, field string) (*messages.Message, error) {
+		text, err := ruleFunc(data, field)
+		if err != nil || len(text) == 0 {
+			return nil, err
 		}
 
-		return "", nil
+		return &messages.Message{Field: field, Text: text}, nil
 	}
 }
 
-func Max(limit int) RuleFunc {
-	return func(data map[string]any, field string) (string, error) {
-		if _, exists := data[field]; !exists {
-			return "", nil
+func Required() Rule {
+	return described(Descriptor{Name: "required"}, func(data map[string]any, field string) (*messages.Message, error) {
+		value, exists := data[field]
+		if !exists || isEmpty(value) {
+			return messages.New("required", field, nil), nil
 		}
 
-		switch value := data[field].(type) {
-		case string:
-			if utf8.RuneCountInString(value) > limit {
-				return fmt.Sprintf("The %s field must not be greater than %d characters", field, limit), nil
-			}
-		case int:
-			if value > limit {
-				return fmt.Sprintf("The %s field must not be greater than %d", field, limit), nil
-			}
-		case uint:
-			if int(value) > limit {
-				return fmt.Sprintf("The %s field must not be greater than %d", field, limit), nil
-			}
-		case int8:
-			if int(value) > limit {
-				return fmt.Sprintf("The %s field must not be greater than %d", field, limit), nil
-			}
-		case uint8:
-			if int(value) > limit {
-				return fmt.Sprintf("The %s field must not be greater than %d", field, limit), nil
-			}
-		case int16:
-			if int(value) > limit {
-				return fmt.Sprintf("The %s field must not be greater than %d", field, limit), nil
-			}
-		case uint16:
-			if int(value) > limit {
-				return fmt.Sprintf("The %s field must not be greater than %d", field, limit), nil
-			}
-		case int32:
-			if int(value) > limit {
-				return fmt.Sprintf("The %s field must not be greater than %d", field, limit), nil
-			}
-		case uint32:
-			if int(value) > limit {
-				return fmt.Sprintf("The %s field must not be greater than %d", field, limit), nil
-			}
-		case int64:
-			if int(value) > limit {
-				return fmt.Sprintf("The %s field must not be greater than %d", field, limit), nil
-			}
-		case uint64:
-			if int(value) > limit {
-				return fmt.Sprintf("The %s field must not be greater than %d", field, limit), nil
-			}
-		case float32:
-			if value > float32(limit) {
-				return fmt.Sprintf("The %s field must not be greater than %d", field, limit), nil
-			}
-		case float64:
-			if value > float64(limit) {
-				return fmt.Sprintf("The %s field must not be greater than %d", field, limit), nil
-			}
-		}
+		return nil, nil
+	})
+}
 
-		reflected := reflect.ValueOf(data[field])
-		kind := reflected.Kind()
-		isIterable := kind == reflect.Slice || kind == reflect.Map || kind == reflect.Array
+// isEmpty reports whether the value is nil, an empty string, a zero number or an empty slice, array or map.
+func isEmpty(value any) bool {
+	switch typedValue := value.(type) {
+	case nil:
+		return true
+	case string:
+		return len(typedValue) == 0
+	case float32:
+		delta := 1e-6
+		return math.Abs(float64(typedValue)) < delta
+	case float64:
+		delta := 1e-6
+		return math.Abs(typedValue) < delta
+	case json.Number:
+		number, ok := toRat(typedValue)
+		return ok && number.Sign() == 0
+	}
 
-		if isIterable && limit < 0 {
-			return "", fmt.Errorf("the max rule limit must not be negative while validating arrays")
-		}
+	reflected := reflect.ValueOf(value)
+	switch reflected.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return reflected.IsZero()
+	case reflect.Slice, reflect.Map, reflect.Array:
+		return reflected.Len() == 0
+	}
 
-		if isIterable && reflected.Len() > limit {
-			return fmt.Sprintf("The %s field must not have more than %d items", field, limit), nil
-		}
+	return false
+}
 
-		return "", nil
-	}
+// Max checks that a number, the length of a string or the size of an array or a map does not exceed the limit.
+// The limit may be any Number, e.g. Max(0.5) or Max(uint64(math.MaxUint64)).
+func Max[N Number](limit N) Rule {
+	return limitRule("max", limit, func(comparison int) bool {
+		return comparison <= 0
+	})
 }
 
-func Min(limit int) RuleFunc {
-	return func(data map[string]any, field string) (string, error) {
-		if _, exists := data[field]; !exists {
-			return "", nil
-		}
+// Min checks that a number, the length of a string or the size of an array or a map is not less than the limit.
+// The limit may be any Number, e.g. Min(json.Number("0.01")).
+func Min[N Number](limit N) Rule {
+	return limitRule("min", limit, func(comparison int) bool {
+		return comparison >= 0
+	})
+}
 
-		switch value := data[field].(type) {
-		case string:
-			if utf8.RuneCountInString(value) < limit {
-				return fmt.Sprintf("The %s field must not be less than %d characters", field, limit), nil
-			}
-		case int:
-			if value < limit {
-				return fmt.Sprintf("The %s field must not be less than %d", field, limit), nil
-			}
-		case uint:
-			if int(value) < limit {
-				return fmt.Sprintf("The %s field must not be less than %d", field, limit), nil
-			}
-		case int8:
-			if int(value) < limit {
-				return fmt.Sprintf("The %s field must not be less than %d", field, limit), nil
-			}
-		case uint8:
-			if int(value) < limit {
-				return fmt.Sprintf("The %s field must not be less than %d", field, limit), nil
-			}
-		case int16:
-			if int(value) < limit {
-				return fmt.Sprintf("The %s field must not be less than %d", field, limit), nil
-			}
-		case uint16:
-			if int(value) < limit {
-				return fmt.Sprintf("The %s field must not be less than %d", field, limit), nil
-			}
-		case int32:
-			if int(value) < limit {
-				return fmt.Sprintf("The %s field must not be less than %d", field, limit), nil
-			}
-		case uint32:
-			if int(value) < limit {
-				return fmt.Sprintf("The %s field must not be less than %d", field, limit), nil
-			}
-		case int64:
-			if int(value) < limit {
-				return fmt.Sprintf("The %s field must not be less than %d", field, limit), nil
-			}
-		case uint64:
-			if int(value) < limit {
-				return fmt.Sprintf("The %s field must not be less than %d", field, limit), nil
-			}
-		case float32:
-			if value < float32(limit) {
-				return fmt.Sprintf("The %s field must not be less than %d", field, limit), nil
-			}
-		case float64:
-			if value < float64(limit) {
-				return fmt.Sprintf("The %s field must not be less than %d", field, limit), nil
-			}
-		}
+func limitRule[N Number](key string, limit N, passes func(comparison int) bool) Rule {
+	limitBound := newBound(limit)
+	params := map[string]any{"limit": displayNumber(limit)}
 
-		reflected := reflect.ValueOf(data[field])
-		kind := reflected.Kind()
-		isIterable := kind == reflect.Slice || kind == reflect.Map || kind == reflect.Array
+	return described(Descriptor{Name: key, Params: map[string]any{"limit": limit}}, func(data map[string]any, field string) (*messages.Message, error) {
+		value, exists := data[field]
+		if !exists {
+			return nil, nil
+		}
 
-		if isIterable && limit < 0 {
-			return "", fmt.Errorf("the min rule limit must not be negative while validating arrays")
+		comparison, kind, ok := measure(value, limitBound)
+		if kind == "array" && limitBound.value.Sign() < 0 {
+			return nil, fmt.Errorf("the %s rule limit must not be negative while validating arrays", key)
 		}
 
-		if isIterable && reflected.Len() < limit {
-			return fmt.Sprintf("The %s field must not have less than %d items", field, limit), nil
+		if !ok || passes(comparison) {
+			return nil, nil
 		}
 
-		return "", nil
-	}
+		return messages.New(key+"."+kind, field, params), nil
+	})
 }
 
-func Date() RuleFunc {
-	return func(data map[string]any, field string) (string, error) {
+// Date checks that the field is a 2006-01-02 date within the 2000-2099 years.
+// Use DateFormat, Before and After for other layouts and ranges.
+func Date() Rule {
+	return described(Descriptor{Name: "date"}, func(data map[string]any, field string) (*messages.Message, error) {
+		if _, exists := data[field]; !exists {
+			return nil, nil
+		}
+
 		value, ok := data[field].(string)
 		if !ok {
-			return "", fmt.Errorf("the value is not string")
+			return messages.New("date", field, nil), nil
 		}
 
 		date, err := time.Parse("2006-01-02", value)
@@ -226,82 +144,51 @@ func Date() RuleFunc {
 		nextCentury := time.Date(2099, 12, 31, 0, 0, 0, 0, time.UTC)
 
 		if err != nil || date.After(nextCentury) || date.Before(previousCentury) {
-			return fmt.Sprintf("The %s field format is invalid", field), nil
+			return messages.New("date", field, nil), nil
 		}
 
-		return "", nil
-	}
+		return nil, nil
+	})
 }
 
-func Regex(pattern string) RuleFunc {
-	return func(data map[string]any, field string) (string, error) {
-		value, ok := data[field].(string)
-		if !ok {
-			return "", fmt.Errorf("the value is not string")
-		}
-
-		if len(value) == 0 {
-			return "", nil
-		}
-
-		regex, err := regexp.Compile(pattern)
-		if err != nil {
-			return "", fmt.Errorf("cannot compile pattern %q: %w", pattern, err)
-		}
-
-		if !regex.MatchString(value) {
-			return fmt.Sprintf("The %s field format is invalid", field), nil
-		}
-
-		return "", nil
-	}
+// Regex compiles the pattern once and panics if it is invalid, like regexp.MustCompile.
+// Use CompiledRegex to handle invalid patterns coming from configuration.
+func Regex(pattern string) Rule {
+	return CompiledRegex(regexp.MustCompile(pattern))
 }
 
-func Password() RuleFunc {
-	return func(data map[string]any, field string) (string, error) {
-		message, err := Min(8)(data, field)
-		if err != nil {
-			return "", fmt.Errorf("cannot validate min length: %w", err)
+// CompiledRegex checks that the field matches the regex. Absent and null fields and empty strings pass,
+// while values of other types fail with the regex message.
+func CompiledRegex(regex *regexp.Regexp) Rule {
+	return described(Descriptor{Name: "regex", Params: map[string]any{"pattern": regex.String()}}, func(data map[string]any, field string) (*messages.Message, error) {
+		rawValue, exists := data[field]
+		if !exists || rawValue == nil {
+			return nil, nil
 		}
 
-		if len(message) != 0 {
-			return message, nil
-		}
-
-		message, err = Regex("[a-z]+")(data, field)
-		if err != nil {
-			return "", fmt.Errorf("cannot validate lower case symbols: %w", err)
-		}
-
-		if len(message) != 0 {
-			return fmt.Sprintf("The %s field must contain at least one lower case letter", field), nil
-		}
-
-		message, err = Regex("[A-Z]+")(data, field)
-		if err != nil {
-			return "", fmt.Errorf("cannot validate upper case symbols: %w", err)
-		}
-
-		if len(message) != 0 {
-			return fmt.Sprintf("The %s field must contain at least one upper case letter", field), nil
+		value, ok := rawValue.(string)
+		if !ok {
+			return messages.New("regex", field, nil), nil
 		}
 
-		message, err = Regex("[0-9]+")(data, field)
-		if err != nil {
-			return "", fmt.Errorf("cannot validate numbers: %w", err)
+		if len(value) == 0 {
+			return nil, nil
 		}
 
-		if len(message) != 0 {
-			return fmt.Sprintf("The %s field must contain at least one number", field), nil
+		if !regex.MatchString(value) {
+			return messages.New("regex", field, nil), nil
 		}
 
-		return "", nil
-	}
+		return nil, nil
+	})
 }
 
-func Same(fieldToMatch string) RuleFunc {
-	return func(data map[string]any, field string) (string, error) {
-		message := fmt.Sprintf("The %s field must match %s", field, fieldToMatch)
+// Same checks that the field equals the other one. Wildcards of the other field stand for the indices of
+// the validated one, e.g. Same("rows.*.a") of "rows.2.b" compares it with "rows.2.a".
+func Same(fieldToMatch string) Rule {
+	return described(Descriptor{Name: "same", Params: map[string]any{"other": fieldToMatch}}, func(data map[string]any, field string) (*messages.Message, error) {
+		fieldToMatch := resolvePath(fieldToMatch, field)
+		message := messages.New("same", field, map[string]any{"other": messages.Field(fieldToMatch)})
 
 		valueToMatch, exists := data[fieldToMatch]
 		if !exists {
@@ -312,6 +199,6 @@ func Same(fieldToMatch string) RuleFunc {
 			return message, nil
 		}
 
-		return "", nil
-	}
+		return nil, nil
+	})
 }
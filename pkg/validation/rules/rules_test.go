@@ -1,6 +1,7 @@
 package rules
 
 import (
+	"encoding/json"
 	"testing"
 	"time"
 )
@@ -14,6 +15,7 @@ func TestRequired(t *testing.T) {
 		want string
 	}{
 		{"Missing field", map[string]any{}, "The test field is required"},
+		{"Null field", map[string]any{"test": nil}, "The test field is required"},
 
 		{"Empty string", map[string]any{"test": ""}, "The test field is required"},
 		{"Non-empty string", map[string]any{"test": "Merlin"}, noError},
@@ -21,6 +23,9 @@ func TestRequired(t *testing.T) {
 		{"Zero number", map[string]any{"test": 0}, "The test field is required"},
 		{"Greater than zero number", map[string]any{"test": 45}, noError},
 		{"Less than zero number", map[string]any{"test": -45}, noError},
+		{"Zero unsigned number", map[string]any{"test": uint64(0)}, "The test field is required"},
+		{"Zero json number", map[string]any{"test": json.Number("0.0")}, "The test field is required"},
+		{"Fractional json number", map[string]any{"test": json.Number("0.5")}, noError},
 
 		{"Empty array", map[string]any{"test": []string{}}, "The test field is required"},
 		{"Non-empty array", map[string]any{"test": []string{"1984", "Crime and punishment"}}, noError},
@@ -31,8 +36,8 @@ func TestRequired(t *testing.T) {
 
 	for _, tt := range tableTests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, _ := Required()(tt.data, "test")
-			if got != tt.want {
+			got, _ := Required().Check(tt.data, "test")
+			if got.String() != tt.want {
 				t.Errorf("got %q, want %q", got, tt.want)
 			}
 		})
@@ -73,8 +78,8 @@ func TestMax(t *testing.T) {
 
 	for _, tt := range tableTests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, _ := ruleFunc(tt.data, "test")
-			if got != tt.want {
+			got, _ := ruleFunc.Check(tt.data, "test")
+			if got.String() != tt.want {
 				t.Errorf("got %q, want %q", got, tt.want)
 			}
 		})
@@ -115,8 +120,8 @@ func TestMin(t *testing.T) {
 
 	for _, tt := range tableTests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, _ := ruleFunc(tt.data, "test")
-			if got != tt.want {
+			got, _ := ruleFunc.Check(tt.data, "test")
+			if got.String() != tt.want {
 				t.Errorf("got %q, want %q", got, tt.want)
 			}
 		})
@@ -140,6 +145,8 @@ func TestDate(t *testing.T) {
 		{"Date fits leap February capacity", map[string]any{"test": "2024-02-29"}, noError},
 		{"Previous century", map[string]any{"test": "1999-12-31"}, "The test field format is invalid"},
 		{"Next century", map[string]any{"test": "2100-01-01"}, "The test field format is invalid"},
+		{"Not a string", map[string]any{"test": 20250101}, "The test field format is invalid"},
+		{"Missing field", map[string]any{}, noError},
 	}
 
 	firstDate, err := time.Parse("2006-01-02", "2024-01-01")
@@ -156,7 +163,7 @@ func TestDate(t *testing.T) {
 
 	for _, tt := range tableTests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got, _ := Date()(tt.data, "test"); got != tt.want {
+			if got, _ := Date().Check(tt.data, "test"); got.String() != tt.want {
 				t.Errorf("got %q, want %q", got, tt.want)
 			}
 		})
@@ -202,11 +209,95 @@ func TestRegex(t *testing.T) {
 		{"Sand two words with a number", Sand, map[string]any{"test": "There are 3 words"}, noError},
 		{"Sand dash", Sand, map[string]any{"test": "2025-12-31"}, noError},
 		{"Sand dot", Sand, map[string]any{"test": "2025.12.31"}, "The test field format is invalid"},
+
+		{"Missing field", Email, map[string]any{}, noError},
+		{"Null field", Email, map[string]any{"test": nil}, noError},
+		{"Number instead of a string", Email, map[string]any{"test": 5}, "The test field format is invalid"},
+	}
+
+	for _, tt := range tableTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, _ := Regex(tt.pattern).Check(tt.data, "test"); got.String() != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRegexPanicsOnInvalidPattern(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected a panic")
+		}
+	}()
+
+	Regex("[a-z")
+}
+
+func TestRegexDoesNotAllocate(t *testing.T) {
+	tableTests := []struct {
+		name     string
+		ruleFunc Rule
+		data     map[string]any
+	}{
+		{"Regex", Regex(Alpha), map[string]any{"test": "Merlin"}},
+		{"Password", Password(), map[string]any{"test": "Excalibur42"}},
+	}
+
+	for _, tt := range tableTests {
+		t.Run(tt.name, func(t *testing.T) {
+			allocs := testing.AllocsPerRun(100, func() {
+				tt.ruleFunc.Check(tt.data, "test")
+			})
+
+			if allocs != 0 {
+				t.Errorf("got %v allocations per run, want 0", allocs)
+			}
+		})
+	}
+}
+
+func BenchmarkRegex(b *testing.B) {
+	ruleFunc := Regex(Email)
+	data := map[string]any{"test": "merlin@camelot.uk"}
+
+	b.ReportAllocs()
+	for b.Loop() {
+		ruleFunc.Check(data, "test")
+	}
+}
+
+func BenchmarkPassword(b *testing.B) {
+	ruleFunc := Password()
+	data := map[string]any{"test": "Excalibur42"}
+
+	b.ReportAllocs()
+	for b.Loop() {
+		ruleFunc.Check(data, "test")
+	}
+}
+
+func TestFromText(t *testing.T) {
+	legacy := func(data map[string]any, field string) (string, error) {
+		if data[field] == "Mordred" {
+			return "The " + field + " field must not be a traitor", nil
+		}
+
+		return "", nil
+	}
+
+	tableTests := []struct {
+		name string
+		data map[string]any
+		want string
+	}{
+		{"Passing value", map[string]any{"test": "Merlin"}, noError},
+		{"Failing value", map[string]any{"test": "Mordred"}, "The test field must not be a traitor"},
 	}
 
 	for _, tt := range tableTests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got, _ := Regex(tt.pattern)(tt.data, "test"); got != tt.want {
+			if got, _ := FromText(legacy)(tt.data, "test"); got.String() != tt.want {
 				t.Errorf("got %q, want %q", got, tt.want)
 			}
 		})
@@ -0,0 +1,90 @@
+package validation
+
+import (
+	"github.com/EugeneNail/motivatr-lib-common/pkg/validation/sanitizers"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// WithSanitizers transforms the values under the paths before the rules run, e.g. {"email": {sanitizers.Trim(),
+// sanitizers.Lower()}}. Paths may contain wildcards like the rule fields. The validator works on a deep copy of the
+// data, leaving the original intact, and exposes the sanitized values with Data.
+func WithSanitizers(fieldSanitizers map[string][]sanitizers.Sanitizer) Option {
+	return func(validator *Validator) {
+		validator.sanitizers = fieldSanitizers
+	}
+}
+
+// sanitize returns a deep copy of the data with the sanitizers applied. Nested values are only reached through
+// map[string]any and []any, the types produced by decoding json.
+func sanitize(data map[string]any, fieldSanitizers map[string][]sanitizers.Sanitizer) map[string]any {
+	sanitized := deepCopy(data).(map[string]any)
+
+	paths := make([]string, 0, len(fieldSanitizers))
+	for path := range fieldSanitizers {
+		paths = append(paths, path)
+	}
+
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		sanitizer := sanitizers.Chain(fieldSanitizers[path]...)
+		sanitizeAt(sanitized, strings.Split(path, pathSeparator), sanitizer)
+	}
+
+	return sanitized
+}
+
+// sanitizeAt applies the sanitizer to the existing values under the path segments of the container.
+func sanitizeAt(container any, segments []string, sanitizer sanitizers.Sanitizer) {
+	segment, last := segments[0], len(segments) == 1
+
+	switch typedContainer := container.(type) {
+	case map[string]any:
+		for key, value := range typedContainer {
+			if segment != wildcard && segment != key {
+				continue
+			}
+
+			if last {
+				typedContainer[key] = sanitizer(value)
+			} else {
+				sanitizeAt(value, segments[1:], sanitizer)
+			}
+		}
+	case []any:
+		for i, value := range typedContainer {
+			if segment != wildcard && segment != strconv.Itoa(i) {
+				continue
+			}
+
+			if last {
+				typedContainer[i] = sanitizer(value)
+			} else {
+				sanitizeAt(value, segments[1:], sanitizer)
+			}
+		}
+	}
+}
+
+func deepCopy(value any) any {
+	switch typedValue := value.(type) {
+	case map[string]any:
+		copied := make(map[string]any, len(typedValue))
+		for key, item := range typedValue {
+			copied[key] = deepCopy(item)
+		}
+
+		return copied
+	case []any:
+		copied := make([]any, len(typedValue))
+		for i, item := range typedValue {
+			copied[i] = deepCopy(item)
+		}
+
+		return copied
+	}
+
+	return value
+}
@@ -0,0 +1,107 @@
+package sanitizers
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Sanitizer transforms a value before validation. Values of the types a sanitizer does not handle are returned as they are.
+type Sanitizer func(value any) any
+
+// Trim removes leading and trailing white space from strings.
+func Trim() Sanitizer {
+	return forStrings(strings.TrimSpace)
+}
+
+// Lower converts strings to lower case, e.g. emails.
+func Lower() Sanitizer {
+	return forStrings(strings.ToLower)
+}
+
+// CollapseSpaces replaces every run of white space in strings with a single space and trims them.
+func CollapseSpaces() Sanitizer {
+	return forStrings(func(value string) string {
+		return strings.Join(strings.Fields(value), " ")
+	})
+}
+
+// StripControl removes control characters from strings, except for tabs and line breaks, together with invisible
+// formatting characters like zero-width spaces, joiners and byte order marks.
+func StripControl() Sanitizer {
+	return forStrings(func(value string) string {
+		return strings.Map(func(character rune) rune {
+			if character == '\t' || character == '\n' || character == '\r' {
+				return character
+			}
+
+			if unicode.IsControl(character) || unicode.Is(unicode.Cf, character) {
+				return -1
+			}
+
+			return character
+		}, value)
+	})
+}
+
+// NullIfEmpty replaces empty strings with nil, so that rules.Nullable and rules.Required treat them as missing values.
+func NullIfEmpty() Sanitizer {
+	return func(value any) any {
+		if value == "" {
+			return nil
+		}
+
+		return value
+	}
+}
+
+// Number converts numeric strings to int64 if they are integers that fit into it and to json.Number otherwise,
+// like DecodeJsonBody does for json numbers, so that the rules compare them exactly. Other strings are kept,
+// so that the rules report them.
+func Number() Sanitizer {
+	return func(value any) any {
+		text, ok := value.(string)
+		if !ok {
+			return value
+		}
+
+		if integer, err := strconv.ParseInt(text, 10, 64); err == nil {
+			return integer
+		}
+
+		if strings.ContainsAny(text, "xX") {
+			return value
+		}
+
+		float, err := strconv.ParseFloat(text, 64)
+		if errors.Is(err, strconv.ErrRange) || err == nil && !math.IsInf(float, 0) && !math.IsNaN(float) {
+			return json.Number(text)
+		}
+
+		return value
+	}
+}
+
+// Chain applies the sanitizers in order.
+func Chain(sanitizers ...Sanitizer) Sanitizer {
+	return func(value any) any {
+		for _, sanitizer := range sanitizers {
+			value = sanitizer(value)
+		}
+
+		return value
+	}
+}
+
+func forStrings(transform func(value string) string) Sanitizer {
+	return func(value any) any {
+		if text, ok := value.(string); ok {
+			return transform(text)
+		}
+
+		return value
+	}
+}
@@ -0,0 +1,43 @@
+package sanitizers
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestSanitizers(t *testing.T) {
+	tableTests := []struct {
+		name      string
+		sanitizer Sanitizer
+		value     any
+		want      any
+	}{
+		{"Trim", Trim(), " \t Merlin \n", "Merlin"},
+		{"Trim of a non-string", Trim(), 42, 42},
+		{"Lower", Lower(), "Merlin@Camelot.UK", "merlin@camelot.uk"},
+		{"Lower of Cyrillic", Lower(), "МЕРЛИН", "мерлин"},
+		{"Collapse spaces", CollapseSpaces(), "  Sir   Lancelot \t du Lac ", "Sir Lancelot du Lac"},
+		{"Strip control", StripControl(), "Mer\u200blin\x00\ufeff", "Merlin"},
+		{"Strip control keeps line breaks", StripControl(), "first\tline\r\nsecond", "first\tline\r\nsecond"},
+		{"Null if empty", NullIfEmpty(), "", nil},
+		{"Null if empty keeps values", NullIfEmpty(), " ", " "},
+		{"Null if empty keeps zeros", NullIfEmpty(), 0, 0},
+		{"Integer", Number(), "42", int64(42)},
+		{"Float", Number(), "-4.5", json.Number("-4.5")},
+		{"Integer beyond int64", Number(), "18446744073709551615", json.Number("18446744073709551615")},
+		{"Float beyond float64", Number(), "1e400", json.Number("1e400")},
+		{"Hexadecimal float", Number(), "0x1p-2", "0x1p-2"},
+		{"Non-numeric string", Number(), "forty two", "forty two"},
+		{"Infinity", Number(), "Inf", "Inf"},
+		{"Chain", Chain(StripControl(), Trim(), NullIfEmpty()), " \u200b ", nil},
+	}
+
+	for _, tt := range tableTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.sanitizer(tt.value); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package validation
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/EugeneNail/motivatr-lib-common/pkg/validation/rules"
+)
+
+// ValidateStruct validates a struct annotated with `validate` tags and returns the errors keyed by the json field names.
+func ValidateStruct(value any, options ...Option) (map[string]string, error) {
+	validator, err := NewStructValidator(value, options...)
+	if err != nil {
+		return nil, fmt.Errorf("creating a validator: %w", err)
+	}
+
+	if err := validator.Validate(); err != nil {
+		return nil, fmt.Errorf("validating the struct: %w", err)
+	}
+
+	return validator.Errors(), nil
+}
+
+// NewStructValidator builds a Validator from the fields and `validate` tags of a struct or a pointer to a struct.
+// The fields are validated in the order of declaration.
+// Tags are comma separated, e.g. `validate:"required,max=255,email"`, and use the rule names of ParseRules.
+// The regex rule consumes the rest of the tag, so it must be the last one.
+func NewStructValidator(value any, options ...Option) (*Validator, error) {
+	reflected := reflect.ValueOf(value)
+	for reflected.Kind() == reflect.Pointer {
+		if reflected.IsNil() {
+			return nil, fmt.Errorf("the value must not be a nil pointer")
+		}
+
+		reflected = reflected.Elem()
+	}
+
+	if reflected.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("the value must be a struct, got %s", reflected.Kind())
+	}
+
+	data := make(map[string]any)
+	var fieldRules []FieldRules
+	if err := collectStructFields(reflected, data, &fieldRules); err != nil {
+		return nil, err
+	}
+
+	return NewOrderedValidator(data, fieldRules, options...), nil
+}
+
+func collectStructFields(reflected reflect.Value, data map[string]any, fieldRules *[]FieldRules) error {
+	reflectedType := reflected.Type()
+
+	for i := 0; i < reflectedType.NumField(); i++ {
+		structField := reflectedType.Field(i)
+		fieldValue := reflected.Field(i)
+
+		name, skip := jsonFieldName(structField)
+		if skip {
+			continue
+		}
+
+		if structField.Anonymous && !hasJsonName(structField) {
+			embedded := fieldValue
+			if embedded.Kind() == reflect.Pointer {
+				if embedded.IsNil() {
+					continue
+				}
+
+				embedded = embedded.Elem()
+			}
+
+			if embedded.Kind() == reflect.Struct {
+				if err := collectStructFields(embedded, data, fieldRules); err != nil {
+					return err
+				}
+
+				continue
+			}
+		}
+
+		if !structField.IsExported() {
+			continue
+		}
+
+		if fieldValue.Kind() == reflect.Pointer && fieldValue.IsNil() {
+			data[name] = nil
+		} else {
+			data[name] = reflect.Indirect(fieldValue).Interface()
+		}
+
+		tag, exists := structField.Tag.Lookup("validate")
+		if !exists || len(tag) == 0 {
+			continue
+		}
+
+		parsed, err := parseValidateTag(tag)
+		if err != nil {
+			return fmt.Errorf("parsing the validate tag of the %s field: %w", structField.Name, err)
+		}
+
+		*fieldRules = append(*fieldRules, FieldRules{Field: name, Rules: parsed})
+	}
+
+	return nil
+}
+
+func jsonFieldName(structField reflect.StructField) (name string, skip bool) {
+	tag := structField.Tag.Get("json")
+	if tag == "-" {
+		return "", true
+	}
+
+	name, _, _ = strings.Cut(tag, ",")
+	if len(name) == 0 {
+		name = structField.Name
+	}
+
+	return name, false
+}
+
+func hasJsonName(structField reflect.StructField) bool {
+	name, _, _ := strings.Cut(structField.Tag.Get("json"), ",")
+	return len(name) > 0
+}
+
+func parseValidateTag(tag string) ([]rules.Rule, error) {
+	var ruleFuncs []rules.Rule
+
+	for len(tag) > 0 {
+		var entry string
+		if strings.HasPrefix(tag, "regex=") {
+			entry, tag = tag, ""
+		} else {
+			entry, tag, _ = strings.Cut(tag, ",")
+		}
+
+		name, argument, _ := strings.Cut(strings.TrimSpace(entry), "=")
+
+		ruleFunc, err := tagRule(name, argument)
+		if err != nil {
+			return nil, err
+		}
+
+		ruleFuncs = append(ruleFuncs, ruleFunc)
+	}
+
+	return ruleFuncs, nil
+}
+
+// tagRule builds a rule of the registry used by ParseRules, so that tags and rule strings share the names.
+// The arguments of a tag are separated by spaces, e.g. `validate:"in=daily weekly,unicode_alpha=Cyrillic Latin"`.
+func tagRule(name string, argument string) (rules.Rule, error) {
+	arguments := strings.Fields(argument)
+	if wholeArgumentRules[name] && len(argument) > 0 {
+		arguments = []string{argument}
+	}
+
+	return buildRule(name, arguments)
+}
@@ -0,0 +1,135 @@
+package validation
+
+import (
+	"testing"
+)
+
+type timestamps struct {
+	CreatedAt string `json:"created_at" validate:"required,date"`
+}
+
+type createGoalRequest struct {
+	timestamps
+	Title    string   `json:"title" validate:"required,max=10"`
+	Email    string   `json:"email" validate:"email"`
+	Code     string   `json:"code" validate:"regex=^[a-z]{1,3}$"`
+	Tags     []string `json:"tags" validate:"min=1"`
+	Note     *string  `json:"note" validate:"required"`
+	Internal string   `json:"-" validate:"required"`
+}
+
+func TestValidateStruct(t *testing.T) {
+	note := "note"
+	tableTests := []struct {
+		name  string
+		value any
+		want  map[string]string
+	}{
+		{
+			"Valid struct",
+			createGoalRequest{timestamps{"2025-01-01"}, "Read", "merlin@camelot.uk", "abc", []string{"books"}, &note, ""},
+			map[string]string{},
+		},
+		{
+			"Pointer to a valid struct",
+			&createGoalRequest{timestamps{"2025-01-01"}, "Read", "merlin@camelot.uk", "abc", []string{"books"}, &note, ""},
+			map[string]string{},
+		},
+		{
+			"Invalid struct",
+			createGoalRequest{timestamps{"2025-01-32"}, "", "merlin", "abcd", []string{}, nil, ""},
+			map[string]string{
+				"created_at": "The created_at field format is invalid",
+				"title":      "The title field is required",
+				"email":      "The email field format is invalid",
+				"code":       "The code field format is invalid",
+				"tags":       "The tags field must not have less than 1 items",
+				"note":       "The note field is required",
+			},
+		},
+		{
+			"Nil optional fields",
+			struct {
+				Email *string `json:"email" validate:"email"`
+				Code  *string `json:"code" validate:"regex=^[a-z]+$"`
+			}{},
+			map[string]string{},
+		},
+		{
+			"Unicode names",
+			struct {
+				FirstName string `json:"first_name" validate:"unicode_alpha=Cyrillic"`
+				LastName  string `json:"last_name" validate:"unicode_sand"`
+			}{"Сергей", "Smith-Jones"},
+			map[string]string{},
+		},
+		{
+			"Name of another script",
+			struct {
+				FirstName string `json:"first_name" validate:"unicode_alpha=Cyrillic"`
+			}{"Sergey"},
+			map[string]string{"first_name": "The first_name field must only contain letters"},
+		},
+		{
+			"Rules with several arguments",
+			struct {
+				Frequency string `json:"frequency" validate:"in=daily weekly"`
+				Code      string `json:"code" validate:"between=3 5,alpha_num"`
+			}{"hourly", "a-b"},
+			map[string]string{
+				"frequency": "The selected frequency is invalid",
+				"code":      "The code field format is invalid",
+			},
+		},
+		{
+			"Too long title",
+			createGoalRequest{timestamps{"2025-01-01"}, "Nostradamus", "", "", []string{"books"}, &note, ""},
+			map[string]string{"title": "The title field must not be greater than 10 characters"},
+		},
+	}
+
+	for _, tt := range tableTests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ValidateStruct(tt.value)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+
+			for field, message := range tt.want {
+				if got[field] != message {
+					t.Errorf("%s: got %q, want %q", field, got[field], message)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateStructRejectsInvalidTags(t *testing.T) {
+	tableTests := []struct {
+		name  string
+		value any
+	}{
+		{"Not a struct", "title"},
+		{"Unknown rule", struct {
+			Title string `validate:"unknown"`
+		}{}},
+		{"Non-integer limit", struct {
+			Title string `validate:"max=ten"`
+		}{}},
+		{"Unknown script", struct {
+			Title string `validate:"unicode_alpha=Klingon"`
+		}{}},
+	}
+
+	for _, tt := range tableTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ValidateStruct(tt.value); err == nil {
+				t.Errorf("expected an error")
+			}
+		})
+	}
+}
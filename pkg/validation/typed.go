@@ -0,0 +1,116 @@
+package validation
+
+import (
+	"context"
+	"fmt"
+	"github.com/EugeneNail/motivatr-lib-common/pkg/validation/rules"
+	"time"
+)
+
+// Schema validates values of T through typed accessors of their fields, so that a getter returning the wrong type
+// or a rule of another kind, e.g. a date rule of an Int field, is a compile error. The accessors take the rules
+// of their kind, see StringRule, while Any takes any rules.Rule. The messages are the ones of NewValidator.
+//
+//	schema := validation.For[CreateGoalRequest]().
+//		String("title", func(request CreateGoalRequest) string { return request.Title }, validation.Required(), validation.StringMax(255)).
+//		Int("priority", func(request CreateGoalRequest) int { return request.Priority }, validation.NumberBetween(1, 5))
+//
+//	validator, err := schema.Validate(request)
+//
+// Every accessor returns a new schema, so schemas may extend a shared base without affecting it.
+type Schema[T any] struct {
+	fields []typedField[T]
+}
+
+type typedField[T any] struct {
+	name      string
+	get       func(value T) any
+	ruleFuncs []rules.Rule
+}
+
+// For starts a Schema of T. Schemas are meant to be built once and reused for every value.
+func For[T any]() *Schema[T] {
+	return &Schema[T]{}
+}
+
+func (schema *Schema[T]) String(name string, get func(value T) string, stringRules ...StringRule) *Schema[T] {
+	return schema.field(name, func(value T) any { return get(value) }, unwrapRules(stringRules, StringRule.stringRule))
+}
+
+func (schema *Schema[T]) Int(name string, get func(value T) int, numberRules ...NumberRule) *Schema[T] {
+	return schema.field(name, func(value T) any { return get(value) }, unwrapRules(numberRules, NumberRule.numberRule))
+}
+
+func (schema *Schema[T]) Int64(name string, get func(value T) int64, numberRules ...NumberRule) *Schema[T] {
+	return schema.field(name, func(value T) any { return get(value) }, unwrapRules(numberRules, NumberRule.numberRule))
+}
+
+func (schema *Schema[T]) Uint(name string, get func(value T) uint, numberRules ...NumberRule) *Schema[T] {
+	return schema.field(name, func(value T) any { return get(value) }, unwrapRules(numberRules, NumberRule.numberRule))
+}
+
+func (schema *Schema[T]) Float(name string, get func(value T) float64, numberRules ...NumberRule) *Schema[T] {
+	return schema.field(name, func(value T) any { return get(value) }, unwrapRules(numberRules, NumberRule.numberRule))
+}
+
+func (schema *Schema[T]) Bool(name string, get func(value T) bool, boolRules ...BoolRule) *Schema[T] {
+	return schema.field(name, func(value T) any { return get(value) }, unwrapRules(boolRules, BoolRule.boolRule))
+}
+
+// Time adds a field that TimeBefore, TimeAfter and the other time rules accept as it is.
+// The zero time is validated as null, so Required rejects it.
+func (schema *Schema[T]) Time(name string, get func(value T) time.Time, timeRules ...TimeRule) *Schema[T] {
+	return schema.field(name, func(value T) any {
+		if date := get(value); !date.IsZero() {
+			return date
+		}
+
+		return nil
+	}, unwrapRules(timeRules, TimeRule.timeRule))
+}
+
+// Any adds a field of any other type, e.g. a slice validated with rules.Each or a pointer validated with rules.Nullable.
+// Its rules are not typed.
+func (schema *Schema[T]) Any(name string, get func(value T) any, ruleFuncs ...rules.Rule) *Schema[T] {
+	return schema.field(name, get, ruleFuncs)
+}
+
+// field returns a copy of the schema with the field added.
+func (schema *Schema[T]) field(name string, get func(value T) any, ruleFuncs []rules.Rule) *Schema[T] {
+	fields := make([]typedField[T], len(schema.fields), len(schema.fields)+1)
+	copy(fields, schema.fields)
+
+	return &Schema[T]{fields: append(fields, typedField[T]{name, get, ruleFuncs})}
+}
+
+func unwrapRules[R any](typedRules []R, unwrap func(rule R) rules.Rule) []rules.Rule {
+	ruleFuncs := make([]rules.Rule, len(typedRules))
+	for i, rule := range typedRules {
+		ruleFuncs[i] = unwrap(rule)
+	}
+
+	return ruleFuncs
+}
+
+// Validate validates the value with a background context. See ValidateContext.
+func (schema *Schema[T]) Validate(value T, options ...Option) (*Validator, error) {
+	return schema.ValidateContext(context.Background(), value, options...)
+}
+
+// ValidateContext runs the rules of the fields in the order they were added and returns the validator holding
+// the errors, e.g. to respond with validator.Errors().
+func (schema *Schema[T]) ValidateContext(ctx context.Context, value T, options ...Option) (*Validator, error) {
+	data := make(map[string]any, len(schema.fields))
+	fieldRules := make([]FieldRules, len(schema.fields))
+	for i, field := range schema.fields {
+		data[field.name] = field.get(value)
+		fieldRules[i] = FieldRules{Field: field.name, Rules: field.ruleFuncs}
+	}
+
+	validator := NewOrderedValidator(data, fieldRules, options...)
+	if err := validator.ValidateContext(ctx); err != nil {
+		return nil, fmt.Errorf("validating the value: %w", err)
+	}
+
+	return validator, nil
+}
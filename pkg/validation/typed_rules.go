@@ -0,0 +1,173 @@
+package validation
+
+import (
+	"github.com/EugeneNail/motivatr-lib-common/pkg/validation/rules"
+)
+
+// StringRule, NumberRule, BoolRule and TimeRule are the rules accepted by the typed accessors of Schema,
+// so that a rule of another kind, e.g. a date rule of an Int field, is a compile error. Required, Nullable and
+// Sometimes fit every kind, other rules are built by the constructors of their kind, e.g. StringMax or NumberMax.
+// A custom rule is given its kind explicitly with AsString, AsNumber, AsBool or AsTime.
+type StringRule interface {
+	stringRule() rules.Rule
+}
+
+type NumberRule interface {
+	numberRule() rules.Rule
+}
+
+type BoolRule interface {
+	boolRule() rules.Rule
+}
+
+type TimeRule interface {
+	timeRule() rules.Rule
+}
+
+type stringKind struct{ rule rules.Rule }
+
+func (kind stringKind) stringRule() rules.Rule { return kind.rule }
+
+type numberKind struct{ rule rules.Rule }
+
+func (kind numberKind) numberRule() rules.Rule { return kind.rule }
+
+type boolKind struct{ rule rules.Rule }
+
+func (kind boolKind) boolRule() rules.Rule { return kind.rule }
+
+type timeKind struct{ rule rules.Rule }
+
+func (kind timeKind) timeRule() rules.Rule { return kind.rule }
+
+// commonKind fits fields of every kind.
+type commonKind struct{ rule rules.Rule }
+
+func (kind commonKind) stringRule() rules.Rule { return kind.rule }
+func (kind commonKind) numberRule() rules.Rule { return kind.rule }
+func (kind commonKind) boolRule() rules.Rule   { return kind.rule }
+func (kind commonKind) timeRule() rules.Rule   { return kind.rule }
+
+// CommonRule is a rule that fits fields of every kind, see Required.
+type CommonRule interface {
+	StringRule
+	NumberRule
+	BoolRule
+	TimeRule
+}
+
+// Required is rules.Required for the typed accessors.
+func Required() CommonRule {
+	return commonKind{rules.Required()}
+}
+
+// Nullable is rules.Nullable for the typed accessors.
+func Nullable() CommonRule {
+	return commonKind{rules.Nullable()}
+}
+
+// Sometimes is rules.Sometimes for the typed accessors.
+func Sometimes() CommonRule {
+	return commonKind{rules.Sometimes()}
+}
+
+// AsString declares the rule as a rule of strings, e.g. AsString(rules.Slug()).
+func AsString(rule rules.Rule) StringRule {
+	return stringKind{rule}
+}
+
+// AsNumber declares the rule as a rule of numbers, e.g. AsNumber(rules.MultipleOf(5)).
+func AsNumber(rule rules.Rule) NumberRule {
+	return numberKind{rule}
+}
+
+// AsBool declares the rule as a rule of booleans.
+func AsBool(rule rules.Rule) BoolRule {
+	return boolKind{rule}
+}
+
+// AsTime declares the rule as a rule of times, e.g. AsTime(rules.AfterIn("today", location)).
+func AsTime(rule rules.Rule) TimeRule {
+	return timeKind{rule}
+}
+
+// StringMin checks that the string has at least the number of characters.
+func StringMin(length int) StringRule {
+	return stringKind{rules.Min(length)}
+}
+
+// StringMax checks that the string has at most the number of characters.
+func StringMax(length int) StringRule {
+	return stringKind{rules.Max(length)}
+}
+
+func StringBetween(min int, max int) StringRule {
+	return stringKind{rules.Between(min, max)}
+}
+
+func StringIn(values ...string) StringRule {
+	return stringKind{rules.In(toAnySlice(values)...)}
+}
+
+// StringRegex is rules.Regex, which panics on an invalid pattern.
+func StringRegex(pattern string) StringRule {
+	return stringKind{rules.Regex(pattern)}
+}
+
+func Email() StringRule {
+	return stringKind{rules.Regex(rules.Email)}
+}
+
+func NumberMin[N rules.Number](limit N) NumberRule {
+	return numberKind{rules.Min(limit)}
+}
+
+func NumberMax[N rules.Number](limit N) NumberRule {
+	return numberKind{rules.Max(limit)}
+}
+
+func NumberBetween[N rules.Number](min N, max N) NumberRule {
+	return numberKind{rules.Between(min, max)}
+}
+
+func NumberIn[N rules.Number](values ...N) NumberRule {
+	return numberKind{rules.In(toAnySlice(values)...)}
+}
+
+func Positive() NumberRule {
+	return numberKind{rules.Positive()}
+}
+
+func NonNegative() NumberRule {
+	return numberKind{rules.NonNegative()}
+}
+
+func BoolIn(values ...bool) BoolRule {
+	return boolKind{rules.In(toAnySlice(values)...)}
+}
+
+// TimeBefore is rules.Before for time fields, e.g. TimeBefore("today") or TimeBefore("2026-01-01").
+func TimeBefore(reference string) TimeRule {
+	return timeKind{rules.Before(reference)}
+}
+
+func TimeBeforeOrEqual(reference string) TimeRule {
+	return timeKind{rules.BeforeOrEqual(reference)}
+}
+
+func TimeAfter(reference string) TimeRule {
+	return timeKind{rules.After(reference)}
+}
+
+func TimeAfterOrEqual(reference string) TimeRule {
+	return timeKind{rules.AfterOrEqual(reference)}
+}
+
+func toAnySlice[V any](values []V) []any {
+	items := make([]any, len(values))
+	for i, value := range values {
+		items[i] = value
+	}
+
+	return items
+}
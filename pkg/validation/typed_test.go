@@ -0,0 +1,107 @@
+package validation
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/EugeneNail/motivatr-lib-common/pkg/validation/rules"
+)
+
+type updateGoalRequest struct {
+	Title    string
+	Priority int
+	Views    int64
+	Stars    uint
+	Progress float64
+	Public   bool
+	Deadline time.Time
+	Tags     []string
+}
+
+var updateGoalSchema = For[updateGoalRequest]().
+	String("title", func(request updateGoalRequest) string { return request.Title }, Required(), StringMax(10)).
+	Int("priority", func(request updateGoalRequest) int { return request.Priority }, NumberBetween(1, 5)).
+	Int64("views", func(request updateGoalRequest) int64 { return request.Views }, NumberMax(int64(1_000_000_000_000))).
+	Uint("stars", func(request updateGoalRequest) uint { return request.Stars }, NumberMax(uint(100))).
+	Float("progress", func(request updateGoalRequest) float64 { return request.Progress }, NumberBetween(0, 1)).
+	Bool("public", func(request updateGoalRequest) bool { return request.Public }, BoolIn(true)).
+	Time("deadline", func(request updateGoalRequest) time.Time { return request.Deadline }, Required(), TimeAfter("2025-01-01")).
+	Any("tags", func(request updateGoalRequest) any { return request.Tags }, rules.Each(rules.Slug()))
+
+func TestSchema(t *testing.T) {
+	deadline := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	tableTests := []struct {
+		name  string
+		value updateGoalRequest
+		want  map[string]string
+	}{
+		{
+			"Valid value",
+			updateGoalRequest{"Read", 3, 10, 5, 0.5, true, deadline, []string{"books"}},
+			map[string]string{},
+		},
+		{
+			"Invalid value",
+			updateGoalRequest{"Nostradamus", 6, 1_000_000_000_001, 101, 1.5, false, time.Time{}, []string{"books", "Reading"}},
+			map[string]string{
+				"title":    "The title field must not be greater than 10 characters",
+				"priority": "The priority field must be between 1 and 5",
+				"views":    "The views field must not be greater than 1000000000000",
+				"stars":    "The stars field must not be greater than 100",
+				"progress": "The progress field must be between 0 and 1",
+				"public":   "The selected public is invalid",
+				"deadline": "The deadline field is required",
+				"tags.1":   "The tags.1 field must only contain lower case letters, numbers and dashes",
+			},
+		},
+		{
+			"Early deadline",
+			updateGoalRequest{"Read", 3, 10, 5, 0.5, true, deadline.AddDate(-1, 0, 0), nil},
+			map[string]string{"deadline": "The deadline field must be a date after 2025-01-01"},
+		},
+	}
+
+	for _, tt := range tableTests {
+		t.Run(tt.name, func(t *testing.T) {
+			validator, err := updateGoalSchema.Validate(tt.value)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got := validator.Errors(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSchemaExtendsACopy(t *testing.T) {
+	base := For[updateGoalRequest]().
+		String("title", func(request updateGoalRequest) string { return request.Title }, Required())
+	withSlug := base.String("title_slug", func(request updateGoalRequest) string { return request.Title }, AsString(rules.Slug()))
+	withPriority := base.Int("priority", func(request updateGoalRequest) int { return request.Priority }, Required())
+
+	tableTests := []struct {
+		name   string
+		schema *Schema[updateGoalRequest]
+		want   map[string]string
+	}{
+		{"Base", base, map[string]string{}},
+		{"Extended with a slug", withSlug, map[string]string{"title_slug": "The title_slug field must only contain lower case letters, numbers and dashes"}},
+		{"Extended with a priority", withPriority, map[string]string{"priority": "The priority field is required"}},
+	}
+
+	for _, tt := range tableTests {
+		t.Run(tt.name, func(t *testing.T) {
+			validator, err := tt.schema.Validate(updateGoalRequest{Title: "Read"})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got := validator.Errors(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
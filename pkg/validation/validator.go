@@ -1,51 +1,397 @@
 package validation
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"github.com/EugeneNail/motivatr-lib-common/pkg/validation/messages"
 	"github.com/EugeneNail/motivatr-lib-common/pkg/validation/rules"
+	"github.com/EugeneNail/motivatr-lib-common/pkg/validation/sanitizers"
+	"sort"
+	"strings"
 )
 
 type Validator struct {
-	data   map[string]any
-	rules  map[string][]rules.RuleFunc
-	errors map[string]string
+	data         map[string]any
+	rules        []FieldRules
+	contextRules map[string][]rules.ContextRuleFunc
+	sanitizers   map[string][]sanitizers.Sanitizer
+	objectRules  []rules.ObjectRule
+	errors       map[string][]*messages.Message
+	failedFields []string // in the order of the first errors
+	collectAll   bool
+	translator   messages.Translator
 }
 
-func NewValidator(data map[string]any, rules map[string][]rules.RuleFunc) *Validator {
-	return &Validator{
-		data:   data,
-		rules:  rules,
-		errors: make(map[string]string),
+// Option configures a Validator created by NewValidator.
+type Option func(validator *Validator)
+
+// CollectAll makes the validator run every rule of a field and keep all failing messages
+// instead of stopping at the first one, including every unmet requirement of rules.Password.
+func CollectAll() Option {
+	return func(validator *Validator) {
+		validator.collectAll = true
+	}
+}
+
+// WithTranslator renders the error messages with the translator, e.g. messages.Russian.
+// Messages are rendered in English by default.
+func WithTranslator(translator messages.Translator) Option {
+	return func(validator *Validator) {
+		validator.translator = translator
+	}
+}
+
+// WithContextRules adds rules that need a context, e.g. rules.Unique and rules.Exists. They run during ValidateContext
+// after the regular rules and only for the fields that have no errors yet, so invalid values never reach the database.
+func WithContextRules(contextRules map[string][]rules.ContextRuleFunc) Option {
+	return func(validator *Validator) {
+		validator.contextRules = contextRules
+	}
+}
+
+// WithObjectRules adds rules that check several fields at once, e.g. rules.AtLeastOneOf("email", "phone").
+// They run after the field rules and the context rules, and only when none of their fields has errors.
+func WithObjectRules(objectRules ...rules.ObjectRule) Option {
+	return func(validator *Validator) {
+		validator.objectRules = append(validator.objectRules, objectRules...)
+	}
+}
+
+// FieldRules are the rules of a field, which may be a path like in ValidateContext.
+type FieldRules struct {
+	Field string
+	Rules []rules.Rule
+}
+
+// NewValidator creates a validator running the rules of the fields in alphabetical order.
+// Use NewOrderedValidator to run them and to report their errors in the order of declaration.
+func NewValidator(data map[string]any, ruleFuncs map[string][]rules.Rule, options ...Option) *Validator {
+	fields := make([]string, 0, len(ruleFuncs))
+	for field := range ruleFuncs {
+		fields = append(fields, field)
 	}
+
+	sort.Strings(fields)
+
+	fieldRules := make([]FieldRules, len(fields))
+	for i, field := range fields {
+		fieldRules[i] = FieldRules{Field: field, Rules: ruleFuncs[field]}
+	}
+
+	return NewOrderedValidator(data, fieldRules, options...)
 }
 
+// NewOrderedValidator creates a validator running the rules of the fields in the given order, e.g.
+//
+//	validator := validation.NewOrderedValidator(data, []validation.FieldRules{
+//		{Field: "title", Rules: []rules.Rule{rules.Required(), rules.Max(255)}},
+//		{Field: "steps.*.name", Rules: []rules.Rule{rules.Required()}},
+//	})
+//
+// OrderedErrors then lists the failed fields in the same order.
+func NewOrderedValidator(data map[string]any, fieldRules []FieldRules, options ...Option) *Validator {
+	validator := &Validator{
+		data:       data,
+		rules:      fieldRules,
+		errors:     make(map[string][]*messages.Message),
+		translator: messages.English,
+	}
+
+	for _, option := range options {
+		option(validator)
+	}
+
+	if len(validator.sanitizers) > 0 {
+		validator.data = sanitize(data, validator.sanitizers)
+	}
+
+	return validator
+}
+
+// Data returns the validated data, sanitized if the validator was created with WithSanitizers,
+// so that handlers can persist the normalized values.
+func (validator *Validator) Data() map[string]any {
+	return validator.data
+}
+
+// Errors returns the first error message of every failed field.
 func (validator *Validator) Errors() map[string]string {
-	return validator.errors
+	errors := make(map[string]string, len(validator.errors))
+	for field, fieldMessages := range validator.errors {
+		errors[field] = validator.translator.Translate(fieldMessages[0])
+	}
+
+	return errors
+}
+
+// FieldError is the first error message of a failed field.
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+// OrderedErrors are the errors of the failed fields that are encoded as a json object with the keys in order.
+type OrderedErrors []FieldError
+
+func (errors OrderedErrors) MarshalJSON() ([]byte, error) {
+	var buffer bytes.Buffer
+	buffer.WriteByte('{')
+
+	for i, fieldError := range errors {
+		if i > 0 {
+			buffer.WriteByte(',')
+		}
+
+		field, err := json.Marshal(fieldError.Field)
+		if err != nil {
+			return nil, err
+		}
+
+		message, err := json.Marshal(fieldError.Message)
+		if err != nil {
+			return nil, err
+		}
+
+		buffer.Write(field)
+		buffer.WriteByte(':')
+		buffer.Write(message)
+	}
+
+	buffer.WriteByte('}')
+
+	return buffer.Bytes(), nil
+}
+
+// OrderedErrors returns the first error message of every failed field in the order of the rule declarations.
+// Fields that match no declaration, e.g. the ones of object rules, follow in the order they failed.
+func (validator *Validator) OrderedErrors() OrderedErrors {
+	fields := make([]string, len(validator.failedFields))
+	copy(fields, validator.failedFields)
+
+	sort.SliceStable(fields, func(i, j int) bool {
+		return validator.declarationIndex(fields[i]) < validator.declarationIndex(fields[j])
+	})
+
+	errors := make(OrderedErrors, len(fields))
+	for i, field := range fields {
+		errors[i] = FieldError{field, validator.translator.Translate(validator.errors[field][0])}
+	}
+
+	return errors
+}
+
+// declarationIndex returns the index of the first rule declaration matching the field or the number of declarations.
+func (validator *Validator) declarationIndex(field string) int {
+	for i, fieldRules := range validator.rules {
+		if matchesPath(fieldRules.Field, field) {
+			return i
+		}
+	}
+
+	return len(validator.rules)
+}
+
+// AllErrors returns every error message of every failed field.
+// Unless the validator was created with CollectAll, each field has at most one message from Validate.
+func (validator *Validator) AllErrors() map[string][]string {
+	errors := make(map[string][]string, len(validator.errors))
+	for field, fieldMessages := range validator.errors {
+		for _, message := range fieldMessages {
+			errors[field] = append(errors[field], validator.translator.Translate(message))
+		}
+	}
+
+	return errors
+}
+
+// Failure is the machine-readable form of a validation error. The code is the catalog key of the
+// message, e.g. "required" or "max.string", and "custom" for errors added with AddError.
+type Failure struct {
+	Code    string         `json:"code"`
+	Params  map[string]any `json:"params,omitempty"`
+	Message string         `json:"message"`
+}
+
+// Failures returns the code, the parameters and the rendered message of every error of every failed field.
+func (validator *Validator) Failures() map[string][]Failure {
+	failures := make(map[string][]Failure, len(validator.errors))
+	for field, fieldMessages := range validator.errors {
+		for _, message := range fieldMessages {
+			failure := Failure{
+				Code:    message.Key,
+				Message: validator.translator.Translate(message),
+			}
+
+			if len(failure.Code) == 0 {
+				failure.Code = "custom"
+			}
+
+			if len(message.Params) > 0 {
+				failure.Params = make(map[string]any, len(message.Params))
+				for name, value := range message.Params {
+					failure.Params[name] = value
+				}
+			}
+
+			failures[field] = append(failures[field], failure)
+		}
+	}
+
+	return failures
 }
 
 func (validator *Validator) Failed() bool {
 	return len(validator.errors) > 0
 }
 
+// Validate runs the rules against the data with a background context. See ValidateContext.
 func (validator *Validator) Validate() error {
-	for field, ruleFuncs := range validator.rules {
-	ruleLoop:
-		for _, ruleFunc := range ruleFuncs {
-			message, err := ruleFunc(validator.data, field)
-			if err != nil {
-				return fmt.Errorf("cannot validate the %s field: %w", field, err)
+	return validator.ValidateContext(context.Background())
+}
+
+// ValidateContext runs the rules against the data, passing the context to the context rules.
+// Rule fields may be dotted paths into nested maps and slices ("goal.title") and may contain wildcards
+// ("steps.*.name"), in which case errors are reported under the concrete paths ("steps.3.name").
+// A rule returning rules.ErrSkip stops the validation of its field.
+func (validator *Validator) ValidateContext(ctx context.Context) error {
+	data := flatten(validator.data)
+	skipped := make(map[string]bool)
+
+	for _, fieldRules := range validator.rules {
+		for _, field := range expandPath(fieldRules.Field, data) {
+		ruleLoop:
+			for _, ruleFunc := range fieldRules.Rules {
+				message, err := ruleFunc.Check(data, field)
+				if errors.Is(err, rules.ErrSkip) {
+					skipped[field] = true
+					break ruleLoop
+				}
+
+				if err != nil {
+					return fmt.Errorf("cannot validate the %s field: %w", field, err)
+				}
+
+				if message != nil {
+					validator.addFieldMessage(field, message)
+					if !validator.collectAll {
+						break ruleLoop
+					}
+				}
 			}
+		}
+	}
 
-			if len(message) > 0 {
-				validator.AddError(field, message)
-				break ruleLoop
+	patterns := make([]string, 0, len(validator.contextRules))
+	for pattern := range validator.contextRules {
+		patterns = append(patterns, pattern)
+	}
+
+	sort.Strings(patterns)
+
+	for _, pattern := range patterns {
+		for _, field := range expandPath(pattern, data) {
+			if skipped[field] || len(validator.errors[field]) > 0 {
+				continue
+			}
+
+		contextRuleLoop:
+			for _, contextRuleFunc := range validator.contextRules[pattern] {
+				message, err := contextRuleFunc(ctx, data, field)
+				if errors.Is(err, rules.ErrSkip) {
+					break contextRuleLoop
+				}
+
+				if err != nil {
+					return fmt.Errorf("cannot validate the %s field: %w", field, err)
+				}
+
+				if message != nil {
+					validator.addFieldMessage(field, message)
+					if !validator.collectAll {
+						break contextRuleLoop
+					}
+				}
 			}
 		}
 	}
 
+	for _, objectRule := range validator.objectRules {
+		if validator.anyFailed(objectRule.Fields) {
+			continue
+		}
+
+		failures, err := objectRule.Check(data)
+		if errors.Is(err, rules.ErrSkip) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("cannot validate the %v fields: %w", objectRule.Fields, err)
+		}
+
+		for _, failure := range failures {
+			validator.addFieldMessage(rules.GeneralField, failure)
+		}
+	}
+
 	return nil
 }
 
+// anyFailed reports whether any of the fields or the values nested in them has errors.
+func (validator *Validator) anyFailed(fields []string) bool {
+	for failedField := range validator.errors {
+		for _, field := range fields {
+			if failedField == field || strings.HasPrefix(failedField, field+pathSeparator) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// addFieldMessage records the message under the field unless the message names its own field,
+// and records the children of messages like the ones of rules.Each instead of the messages themselves.
+// Unless all errors are collected, only the first child reported under the field of the message is kept,
+// e.g. the first unmet requirement of rules.Password.
+func (validator *Validator) addFieldMessage(field string, message *messages.Message) {
+	if len(message.Field) == 0 {
+		message.Field = field
+	}
+
+	if len(message.Children) == 0 {
+		validator.AddMessage(message)
+		return
+	}
+
+	for _, child := range message.Children {
+		sameField := len(child.Field) == 0 || child.Field == message.Field
+		if sameField && !validator.collectAll && len(validator.errors[message.Field]) > 0 {
+			continue
+		}
+
+		validator.addFieldMessage(message.Field, child)
+	}
+}
+
+// AddError replaces the errors of the field with the message, which is shown as it is, e.g. after a check
+// done outside of the rules. Use AddMessage to keep the other errors of the field.
 func (validator *Validator) AddError(field string, message string) {
-	validator.errors[field] = message
+	if len(validator.errors[field]) == 0 {
+		validator.failedFields = append(validator.failedFields, field)
+	}
+
+	validator.errors[field] = []*messages.Message{{Field: field, Text: message}}
+}
+
+// AddMessage records a failure under the field of the message, so that it is rendered by the translator.
+func (validator *Validator) AddMessage(message *messages.Message) {
+	if len(validator.errors[message.Field]) == 0 {
+		validator.failedFields = append(validator.failedFields, message.Field)
+	}
+
+	validator.errors[message.Field] = append(validator.errors[message.Field], message)
 }
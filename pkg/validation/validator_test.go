@@ -0,0 +1,506 @@
+package validation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/EugeneNail/motivatr-lib-common/pkg/validation/messages"
+	"github.com/EugeneNail/motivatr-lib-common/pkg/validation/rules"
+	"github.com/EugeneNail/motivatr-lib-common/pkg/validation/sanitizers"
+)
+
+func TestValidateNestedPaths(t *testing.T) {
+	data := map[string]any{
+		"goal": map[string]any{"title": ""},
+		"steps": []any{
+			map[string]any{"name": "Buy a book"},
+			map[string]any{"name": ""},
+			map[string]any{},
+			map[string]any{"name": "Read the book"},
+		},
+		"tags": map[string]any{"first": "books", "second": "Reading more"},
+	}
+
+	validator := NewValidator(data, map[string][]rules.Rule{
+		"goal.title":   {rules.Required()},
+		"goal.missing": {rules.Required()},
+		"steps.*.name": {rules.Required(), rules.Max(10)},
+		"tags.*":       {rules.Regex(rules.Alpha)},
+		"missing.*.id": {rules.Required()},
+	})
+
+	if err := validator.Validate(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := map[string]string{
+		"goal.title":   "The goal.title field is required",
+		"goal.missing": "The goal.missing field is required",
+		"steps.1.name": "The steps.1.name field is required",
+		"steps.2.name": "The steps.2.name field is required",
+		"steps.3.name": "The steps.3.name field must not be greater than 10 characters",
+		"tags.second":  "The tags.second field format is invalid",
+	}
+
+	got := validator.Errors()
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	for field, message := range want {
+		if got[field] != message {
+			t.Errorf("%s: got %q, want %q", field, got[field], message)
+		}
+	}
+}
+
+func TestValidateConditionsOfSiblings(t *testing.T) {
+	data := map[string]any{
+		"steps": []any{
+			map[string]any{"enabled": true},
+			map[string]any{"enabled": false},
+			map[string]any{"enabled": true, "time": "09:00"},
+		},
+	}
+
+	validator := NewValidator(data, map[string][]rules.Rule{
+		"steps.*.time": {rules.RequiredIf("steps.*.enabled", true)},
+	})
+
+	if err := validator.Validate(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := map[string]string{"steps.0.time": "The steps.0.time field is required when steps.0.enabled is true"}
+	if got := validator.Errors(); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestValidateCollectAll(t *testing.T) {
+	data := map[string]any{"password": "secret"}
+	want := []string{
+		"The password field must not be less than 8 characters",
+		"The password field must contain at least one upper case letter",
+		"The password field must contain at least one number",
+	}
+
+	tableTests := []struct {
+		name      string
+		ruleFuncs []rules.Rule
+	}{
+		{"Separate password rules", rules.PasswordRules()},
+		{"Password rule", []rules.Rule{rules.Password()}},
+	}
+
+	for _, tt := range tableTests {
+		t.Run(tt.name, func(t *testing.T) {
+			ruleFuncs := map[string][]rules.Rule{"password": tt.ruleFuncs}
+			validator := NewValidator(data, ruleFuncs, CollectAll())
+			if err := validator.Validate(); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			got := validator.AllErrors()["password"]
+			if len(got) != len(want) {
+				t.Fatalf("got %q, want %q", got, want)
+			}
+
+			for i := range want {
+				if got[i] != want[i] {
+					t.Errorf("got %q, want %q", got[i], want[i])
+				}
+			}
+
+			if validator.Errors()["password"] != want[0] {
+				t.Errorf("got %q, want %q", validator.Errors()["password"], want[0])
+			}
+
+			bailing := NewValidator(data, ruleFuncs)
+			if err := bailing.Validate(); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got := bailing.AllErrors()["password"]; len(got) != 1 || got[0] != want[0] {
+				t.Errorf("got %q, want only %q", got, want[0])
+			}
+		})
+	}
+}
+
+func TestValidateWithTranslator(t *testing.T) {
+	translator := messages.Russian.WithFields(map[string]string{"title": "название"})
+	validator := NewValidator(
+		map[string]any{"title": ""},
+		map[string][]rules.Rule{"title": {rules.Required()}},
+		WithTranslator(translator),
+	)
+
+	if err := validator.Validate(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := "Поле название обязательно для заполнения"
+	if got := validator.Errors()["title"]; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestValidateFailures(t *testing.T) {
+	validator := NewValidator(
+		map[string]any{"title": "Nostradamus", "password": "secret"},
+		map[string][]rules.Rule{"title": {rules.Max(10)}, "password": rules.PasswordRules()},
+		CollectAll(),
+	)
+
+	if err := validator.Validate(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	validator.AddError("email", "The email field is taken")
+
+	failures := validator.Failures()
+
+	title := failures["title"]
+	if len(title) != 1 || title[0].Code != "max.string" || title[0].Params["limit"] != 10 {
+		t.Errorf("got %+v, want a max.string failure with the limit of 10", title)
+	}
+
+	if title[0].Message != "The title field must not be greater than 10 characters" {
+		t.Errorf("got %q", title[0].Message)
+	}
+
+	var codes []string
+	for _, failure := range failures["password"] {
+		codes = append(codes, failure.Code)
+	}
+
+	want := []string{"min.string", "password.uppercase", "password.number"}
+	if len(codes) != len(want) {
+		t.Fatalf("got %q, want %q", codes, want)
+	}
+
+	for i := range want {
+		if codes[i] != want[i] {
+			t.Errorf("got %q, want %q", codes[i], want[i])
+		}
+	}
+
+	if email := failures["email"]; len(email) != 1 || email[0].Code != "custom" || email[0].Message != "The email field is taken" {
+		t.Errorf("got %+v, want a custom failure", email)
+	}
+}
+
+func TestValidateSkipsRemainingRules(t *testing.T) {
+	validator := NewValidator(
+		map[string]any{"reminders_enabled": true, "birthday": nil},
+		map[string][]rules.Rule{
+			"nickname":      {rules.Sometimes(), rules.Required()},
+			"birthday":      {rules.Nullable(), rules.Date()},
+			"reminder_time": {rules.RequiredIf("reminders_enabled", true), rules.Max(5)},
+		},
+	)
+
+	if err := validator.Validate(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := map[string]string{"reminder_time": "The reminder_time field is required when reminders_enabled is true"}
+	got := validator.Errors()
+	if len(got) != len(want) || got["reminder_time"] != want["reminder_time"] {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestValidateContextRules(t *testing.T) {
+	type keyType struct{}
+	ctx := context.WithValue(context.Background(), keyType{}, "taken@camelot.uk")
+
+	unique := func(ctx context.Context, data map[string]any, field string) (*messages.Message, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		if data[field] == ctx.Value(keyType{}) {
+			return messages.New("unique", field, nil), nil
+		}
+
+		return nil, nil
+	}
+
+	tableTests := []struct {
+		name  string
+		email string
+		want  string
+	}{
+		{"Unique email", "merlin@camelot.uk", ""},
+		{"Taken email", "taken@camelot.uk", "The email has already been taken"},
+		{"Invalid email", "taken", "The email field format is invalid"},
+	}
+
+	for _, tt := range tableTests {
+		t.Run(tt.name, func(t *testing.T) {
+			validator := NewValidator(
+				map[string]any{"email": tt.email},
+				map[string][]rules.Rule{"email": {rules.Regex(rules.Email)}},
+				WithContextRules(map[string][]rules.ContextRuleFunc{"email": {unique}}),
+				CollectAll(),
+			)
+
+			if err := validator.ValidateContext(ctx); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			got := validator.AllErrors()["email"]
+			if len(tt.want) == 0 && len(got) != 0 || len(tt.want) != 0 && (len(got) != 1 || got[0] != tt.want) {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+
+	validator := NewValidator(
+		map[string]any{"email": "merlin@camelot.uk"},
+		nil,
+		WithContextRules(map[string][]rules.ContextRuleFunc{"email": {unique}}),
+	)
+
+	if err := validator.ValidateContext(cancelled); !errors.Is(err, context.Canceled) {
+		t.Errorf("got %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestValidateEach(t *testing.T) {
+	validator := NewValidator(
+		map[string]any{"tags": []any{"books", "", "sport", "books"}},
+		map[string][]rules.Rule{"tags": {rules.Each(rules.Required()), rules.Distinct()}},
+		CollectAll(),
+	)
+
+	if err := validator.Validate(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := map[string]string{
+		"tags.1": "The tags.1 field is required",
+		"tags":   "The tags field has a duplicate value",
+	}
+
+	got := validator.Errors()
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	for field, message := range want {
+		if got[field] != message {
+			t.Errorf("%s: got %q, want %q", field, got[field], message)
+		}
+	}
+}
+
+func TestValidateSanitizedData(t *testing.T) {
+	data := map[string]any{
+		"email": " Merlin@Camelot.UK ",
+		"name":  "   ",
+		"age":   "42",
+		"total": "18446744073709551615",
+		"steps": []any{map[string]any{"name": "  Read   the\u200b book "}},
+	}
+
+	validator := NewValidator(data, map[string][]rules.Rule{
+		"email":        {rules.Required(), rules.Regex(rules.Email)},
+		"name":         {rules.Required()},
+		"age":          {rules.Between(18, 99)},
+		"total":        {rules.Max(uint64(math.MaxUint64))},
+		"steps.*.name": {rules.Max(13)},
+	}, WithSanitizers(map[string][]sanitizers.Sanitizer{
+		"email":        {sanitizers.Trim(), sanitizers.Lower()},
+		"name":         {sanitizers.Trim()},
+		"age":          {sanitizers.Number()},
+		"total":        {sanitizers.Number()},
+		"steps.*.name": {sanitizers.StripControl(), sanitizers.CollapseSpaces()},
+		"missing":      {sanitizers.NullIfEmpty()},
+	}))
+
+	if err := validator.Validate(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := map[string]string{"name": "The name field is required"}
+	if got := validator.Errors(); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	wantData := map[string]any{
+		"email": "merlin@camelot.uk",
+		"name":  "",
+		"age":   int64(42),
+		"total": json.Number("18446744073709551615"),
+		"steps": []any{map[string]any{"name": "Read the book"}},
+	}
+
+	if got := validator.Data(); !reflect.DeepEqual(got, wantData) {
+		t.Errorf("got %v, want %v", got, wantData)
+	}
+
+	if data["email"] != " Merlin@Camelot.UK " || data["steps"].([]any)[0].(map[string]any)["name"] != "  Read   the\u200b book " {
+		t.Errorf("the original data was modified: %v", data)
+	}
+}
+
+func TestValidateObjectRules(t *testing.T) {
+	endAfterStart := rules.ObjectRule{
+		Fields: []string{"start_date", "end_date"},
+		Check: func(data map[string]any) ([]*messages.Message, error) {
+			if data["end_date"].(string) <= data["start_date"].(string) {
+				return []*messages.Message{{Field: "end_date", Text: "The :field field must be after the start date"}}, nil
+			}
+
+			return nil, nil
+		},
+	}
+
+	tableTests := []struct {
+		name string
+		data map[string]any
+		want map[string]string
+	}{
+		{
+			"Valid object",
+			map[string]any{"email": "merlin@camelot.uk", "start_date": "2025-01-01", "end_date": "2025-02-01"},
+			map[string]string{},
+		},
+		{
+			"Broken invariants",
+			map[string]any{"start_date": "2025-02-01", "end_date": "2025-01-01"},
+			map[string]string{
+				"_":        "At least one of the email, phone fields is required",
+				"end_date": "The end_date field must be after the start date",
+			},
+		},
+		{
+			"Invariant of a failed field",
+			map[string]any{"phone": "+79991234567", "start_date": "2025-02-01", "end_date": "2025-13-01"},
+			map[string]string{"end_date": "The end_date field format is invalid"},
+		},
+	}
+
+	for _, tt := range tableTests {
+		t.Run(tt.name, func(t *testing.T) {
+			validator := NewValidator(tt.data, map[string][]rules.Rule{
+				"start_date": {rules.Required(), rules.Date()},
+				"end_date":   {rules.Required(), rules.Date()},
+			}, WithObjectRules(rules.AtLeastOneOf("email", "phone"), endAfterStart))
+
+			if err := validator.Validate(); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got := validator.Errors(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOrderedErrors(t *testing.T) {
+	data := map[string]any{
+		"title": "",
+		"steps": []any{map[string]any{"name": ""}, map[string]any{"name": "Read"}, map[string]any{}},
+		"email": "merlin",
+	}
+
+	validator := NewOrderedValidator(data, []FieldRules{
+		{Field: "title", Rules: []rules.Rule{rules.Required()}},
+		{Field: "steps.*.name", Rules: []rules.Rule{rules.Required()}},
+		{Field: "email", Rules: []rules.Rule{rules.Regex(rules.Email)}},
+	}, WithObjectRules(rules.AtLeastOneOf("phone", "telegram")))
+
+	if err := validator.Validate(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	validator.AddError("description", "The description field is too vague")
+
+	got, err := json.Marshal(validator.OrderedErrors())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := `{"title":"The title field is required",` +
+		`"steps.0.name":"The steps.0.name field is required",` +
+		`"steps.2.name":"The steps.2.name field is required",` +
+		`"email":"The email field format is invalid",` +
+		`"_":"At least one of the phone, telegram fields is required",` +
+		`"description":"The description field is too vague"}`
+
+	if string(got) != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func TestValidateReturnsTheFirstDeclaredError(t *testing.T) {
+	failing := func(name string) rules.RuleFunc {
+		return func(data map[string]any, field string) (*messages.Message, error) {
+			return nil, errors.New(name)
+		}
+	}
+
+	for range 20 {
+		validator := NewValidator(map[string]any{}, map[string][]rules.Rule{
+			"b": {failing("b")},
+			"a": {failing("a")},
+			"c": {failing("c")},
+		})
+
+		if err := validator.Validate(); err == nil || !strings.HasSuffix(err.Error(), ": a") {
+			t.Fatalf("got %v, want the error of a", err)
+		}
+	}
+}
+
+func TestStructErrorsFollowTheDeclarationOrder(t *testing.T) {
+	validator, err := NewStructValidator(struct {
+		Title string `json:"title" validate:"required"`
+		Body  string `json:"body" validate:"required"`
+		Alias string `json:"alias" validate:"required"`
+	}{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := validator.Validate(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var got []string
+	for _, fieldError := range validator.OrderedErrors() {
+		got = append(got, fieldError.Field)
+	}
+
+	if want := []string{"title", "body", "alias"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestAddErrorReplacesErrors(t *testing.T) {
+	validator := NewValidator(map[string]any{"title": ""}, map[string][]rules.Rule{"title": {rules.Required()}})
+	if err := validator.Validate(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	validator.AddError("title", "The title field is taken")
+	validator.AddError("title", "The title field is reserved")
+
+	want := map[string][]string{"title": {"The title field is reserved"}}
+	if got := validator.AllErrors(); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}